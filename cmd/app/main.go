@@ -5,6 +5,7 @@ import (
 	"log"
 	"os/signal"
 	"syscall"
+	_ "time/tzdata"
 
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/tdenkov123/avitotech_internship_2025/internal/config"
@@ -35,7 +36,15 @@ func main() {
 	}
 	defer dbPool.Close()
 
-	svc := service.New(dbPool)
+	var opts []service.Option
+	if cfg.SeedPerPR {
+		opts = append(opts, service.WithPullRequestSeed())
+	}
+	if cfg.AvailableWithin > 0 {
+		opts = append(opts, service.WithAvailabilityWindow(cfg.AvailableWithin))
+	}
+
+	svc := service.New(dbPool, opts...)
 	srv := httpserver.New(cfg, logg, svc)
 
 	logg.Info("starting HTTP server", zap.String("port", cfg.ServerPort))
@@ -11,6 +11,8 @@ type Config struct {
 	DatabaseURL     string        `envconfig:"DATABASE_URL" required:"true"`
 	LogLevel        string        `envconfig:"LOG_LEVEL" default:"info"`
 	ShutdownTimeout time.Duration `envconfig:"SHUTDOWN_TIMEOUT" default:"10s"`
+	SeedPerPR       bool          `envconfig:"REVIEWER_SEED_PER_PR" default:"false"`
+	AvailableWithin time.Duration `envconfig:"REVIEWER_AVAILABLE_WITHIN" default:"0s"`
 }
 
 func LoadConfig() (Config, error) {
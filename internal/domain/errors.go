@@ -3,14 +3,28 @@ package domain
 import "errors"
 
 var (
-	ErrTeamExists          = errors.New("team already exists")
-	ErrTeamNotFound        = errors.New("team not found")
-	ErrUserNotFound        = errors.New("user not found")
-	ErrPullRequestNotFound = errors.New("pull request not found")
-	ErrPullRequestExists   = errors.New("pull request already exists")
-	ErrUserHasOpenPR       = errors.New("user already has open pull request")
-	ErrPullRequestMerged   = errors.New("pull request already merged")
-	ErrReviewerNotAssigned = errors.New("reviewer not assigned to pull request")
-	ErrNoCandidate         = errors.New("no replacement candidate")
-	ErrInvalidInput        = errors.New("invalid input")
+	ErrTeamExists            = errors.New("team already exists")
+	ErrTeamNotFound          = errors.New("team not found")
+	ErrUserNotFound          = errors.New("user not found")
+	ErrPullRequestNotFound   = errors.New("pull request not found")
+	ErrPullRequestExists     = errors.New("pull request already exists")
+	ErrUserHasOpenPR         = errors.New("author reached open pull request limit")
+	ErrPullRequestMerged     = errors.New("pull request already merged")
+	ErrReviewerNotAssigned   = errors.New("reviewer not assigned to pull request")
+	ErrNoCandidate           = errors.New("no replacement candidate")
+	ErrInvalidInput          = errors.New("invalid input")
+	ErrUnknownStrategy       = errors.New("unknown reviewer strategy")
+	ErrNotEnoughReviewers    = errors.New("not enough reviewer candidates")
+	ErrReviewersAtCapacity   = errors.New("all candidates are at review capacity")
+	ErrOwnershipRuleNotFound = errors.New("ownership rule not found")
+	ErrNoSeniorCandidate     = errors.New("no senior replacement candidate")
+	ErrReviewerPinned        = errors.New("reviewer is pinned to pull request")
+	ErrReviewDecided         = errors.New("reviewer already submitted a decision")
+	ErrNotApproved           = errors.New("pull request is not approved")
+	ErrPullRequestClosed     = errors.New("pull request is closed")
+	ErrPullRequestDraft      = errors.New("pull request is a draft")
+	ErrInvalidTransition     = errors.New("invalid pull request status transition")
+	ErrVersionMismatch       = errors.New("pull request version mismatch")
+	ErrRepositoryExists      = errors.New("repository already exists")
+	ErrRepositoryNotFound    = errors.New("repository not found")
 )
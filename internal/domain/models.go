@@ -3,37 +3,168 @@ package domain
 import "time"
 
 type Team struct {
-	Name    string
-	Members []TeamMember
+	Name             string
+	ReviewerStrategy string
+	Members          []TeamMember
+}
+
+const (
+	MinReviewersPolicyWarn   = "WARN"
+	MinReviewersPolicyReject = "REJECT"
+)
+
+const (
+	PullRequestStatusDraft  = "DRAFT"
+	PullRequestStatusOpen   = "OPEN"
+	PullRequestStatusMerged = "MERGED"
+	PullRequestStatusClosed = "CLOSED"
+)
+
+const (
+	ReviewStatePending          = "PENDING"
+	ReviewStateApproved         = "APPROVED"
+	ReviewStateChangesRequested = "CHANGES_REQUESTED"
+	ReviewStateCommented        = "COMMENTED"
+)
+
+const (
+	DeclineReasonBusy               = "busy"
+	DeclineReasonConflictOfInterest = "conflict_of_interest"
+	DeclineReasonLacksContext       = "lacks_context"
+)
+
+const (
+	RoleJunior = "junior"
+	RoleMiddle = "middle"
+	RoleSenior = "senior"
+	RoleLead   = "lead"
+)
+
+// DefaultRepository holds pull requests created with a flat ID and no
+// repository.
+const DefaultRepository = "default"
+
+// Repository groups pull requests numbered within it. DefaultTeam, when set,
+// reviews its pull requests instead of the author's team; the nil settings
+// fall back to that team's settings.
+type Repository struct {
+	Name              string
+	DefaultTeam       string
+	ReviewerCount     *int
+	MinReviewers      *int
+	RequiredApprovals *int
+	CreatedAt         time.Time
+}
+
+type TeamSettings struct {
+	TeamName           string
+	ReviewerCount      int
+	MinReviewers       int
+	MinReviewersPolicy string
+	MinSeniorReviewers int
+	RequiredApprovals  int
+	MaxOpenPRs         int
+	FallbackTeams      []string
+	PairingWindowPRs   int
+	PairingWindowDays  int
 }
 
 type TeamMember struct {
-	UserID   string
-	Username string
-	IsActive bool
+	UserID         string
+	Username       string
+	IsActive       bool
+	Role           string
+	MaxOpenReviews *int
+	ReviewWeight   *int
+	Skills         []string
 }
 
 type User struct {
-	ID       string
-	Username string
-	TeamName string
-	IsActive bool
+	ID             string
+	Username       string
+	TeamName       string
+	IsActive       bool
+	Role           string
+	MaxOpenReviews *int
+	ReviewWeight   int
+	Skills         []string
+	Timezone       string
+	WorkStart      string
+	WorkEnd        string
 }
 
 type PullRequest struct {
 	ID                string
 	Name              string
+	Description       string
+	Repository        string
+	Number            *int
+	Size              *DiffSize
 	AuthorID          string
 	Status            string
+	Version           int
 	AssignedReviewers []string
+	FallbackReviewers []string
+	PinnedReviewers   []string
+	ReviewRound       int
+	Reviews           []Review
+	ReviewHistory     []ReviewRound
+	Labels            []string
+	MergeOverride     bool
 	CreatedAt         time.Time
 	MergedAt          *time.Time
+	ClosedAt          *time.Time
+}
+
+type Review struct {
+	ReviewerID string
+	Round      int
+	State      string
+	AssignedAt time.Time
+	DecidedAt  *time.Time
+}
+
+// ReviewRound holds the final reviewer decisions of a finished round.
+type ReviewRound struct {
+	Round   int
+	Reviews []Review
 }
 
 type PullRequestShort struct {
-	ID        string
-	Name      string
-	AuthorID  string
-	Status    string
-	CreatedAt time.Time
+	ID           string
+	Name         string
+	Description  string
+	Repository   string
+	Number       *int
+	Size         *DiffSize
+	Labels       []string
+	AuthorID     string
+	Status       string
+	WaitingRound *int
+	CreatedAt    time.Time
+}
+
+type DiffSize struct {
+	LinesAdded   int
+	LinesRemoved int
+	FilesChanged int
+}
+
+type OwnershipRule struct {
+	ID       int64
+	Pattern  string
+	UserID   string
+	TeamName string
+}
+
+type Pairing struct {
+	AuthorID   string
+	ReviewerID string
+	Count      int
+}
+
+type PairingStats struct {
+	TeamName string
+	Days     int
+	Pairings []Pairing
 }
@@ -17,26 +17,119 @@ import (
 
 // Defines values for ErrorResponseErrorCode.
 const (
-	NOCANDIDATE ErrorResponseErrorCode = "NO_CANDIDATE"
-	NOTASSIGNED ErrorResponseErrorCode = "NOT_ASSIGNED"
-	NOTFOUND    ErrorResponseErrorCode = "NOT_FOUND"
-	PREXISTS    ErrorResponseErrorCode = "PR_EXISTS"
-	PRMERGED    ErrorResponseErrorCode = "PR_MERGED"
-	TEAMEXISTS  ErrorResponseErrorCode = "TEAM_EXISTS"
+	ATCAPACITY           ErrorResponseErrorCode = "AT_CAPACITY"
+	INVALIDTRANSITION    ErrorResponseErrorCode = "INVALID_TRANSITION"
+	NOCANDIDATE          ErrorResponseErrorCode = "NO_CANDIDATE"
+	NOTAPPROVED          ErrorResponseErrorCode = "NOT_APPROVED"
+	NOTASSIGNED          ErrorResponseErrorCode = "NOT_ASSIGNED"
+	NOTENOUGHREVIEWERS   ErrorResponseErrorCode = "NOT_ENOUGH_REVIEWERS"
+	NOTFOUND             ErrorResponseErrorCode = "NOT_FOUND"
+	OPENPRLIMIT          ErrorResponseErrorCode = "OPEN_PR_LIMIT"
+	PRCLOSED             ErrorResponseErrorCode = "PR_CLOSED"
+	PRDRAFT              ErrorResponseErrorCode = "PR_DRAFT"
+	PRECONDITIONREQUIRED ErrorResponseErrorCode = "PRECONDITION_REQUIRED"
+	PREXISTS             ErrorResponseErrorCode = "PR_EXISTS"
+	PRMERGED             ErrorResponseErrorCode = "PR_MERGED"
+	REPOSITORYEXISTS     ErrorResponseErrorCode = "REPOSITORY_EXISTS"
+	REVIEWDECIDED        ErrorResponseErrorCode = "REVIEW_DECIDED"
+	REVIEWERPINNED       ErrorResponseErrorCode = "REVIEWER_PINNED"
+	TEAMEXISTS           ErrorResponseErrorCode = "TEAM_EXISTS"
+	VERSIONMISMATCH      ErrorResponseErrorCode = "VERSION_MISMATCH"
 )
 
 // Defines values for PullRequestStatus.
 const (
+	PullRequestStatusCLOSED PullRequestStatus = "CLOSED"
+	PullRequestStatusDRAFT  PullRequestStatus = "DRAFT"
 	PullRequestStatusMERGED PullRequestStatus = "MERGED"
 	PullRequestStatusOPEN   PullRequestStatus = "OPEN"
 )
 
 // Defines values for PullRequestShortStatus.
 const (
+	PullRequestShortStatusCLOSED PullRequestShortStatus = "CLOSED"
+	PullRequestShortStatusDRAFT  PullRequestShortStatus = "DRAFT"
 	PullRequestShortStatusMERGED PullRequestShortStatus = "MERGED"
 	PullRequestShortStatusOPEN   PullRequestShortStatus = "OPEN"
 )
 
+// Defines values for ReviewState.
+const (
+	ReviewStateAPPROVED         ReviewState = "APPROVED"
+	ReviewStateCHANGESREQUESTED ReviewState = "CHANGES_REQUESTED"
+	ReviewStateCOMMENTED        ReviewState = "COMMENTED"
+	ReviewStatePENDING          ReviewState = "PENDING"
+)
+
+// Defines values for TeamSettingsMinReviewersPolicy.
+const (
+	TeamSettingsMinReviewersPolicyREJECT TeamSettingsMinReviewersPolicy = "REJECT"
+	TeamSettingsMinReviewersPolicyWARN   TeamSettingsMinReviewersPolicy = "WARN"
+)
+
+// Defines values for UserRole.
+const (
+	Junior UserRole = "junior"
+	Lead   UserRole = "lead"
+	Middle UserRole = "middle"
+	Senior UserRole = "senior"
+)
+
+// Defines values for PostPullRequestDeclineJSONBodyReason.
+const (
+	Busy               PostPullRequestDeclineJSONBodyReason = "busy"
+	ConflictOfInterest PostPullRequestDeclineJSONBodyReason = "conflict_of_interest"
+	LacksContext       PostPullRequestDeclineJSONBodyReason = "lacks_context"
+)
+
+// Defines values for PostPullRequestSubmitReviewJSONBodyState.
+const (
+	PostPullRequestSubmitReviewJSONBodyStateAPPROVED         PostPullRequestSubmitReviewJSONBodyState = "APPROVED"
+	PostPullRequestSubmitReviewJSONBodyStateCHANGESREQUESTED PostPullRequestSubmitReviewJSONBodyState = "CHANGES_REQUESTED"
+	PostPullRequestSubmitReviewJSONBodyStateCOMMENTED        PostPullRequestSubmitReviewJSONBodyState = "COMMENTED"
+)
+
+// Defines values for PostTeamSettingsJSONBodyMinReviewersPolicy.
+const (
+	PostTeamSettingsJSONBodyMinReviewersPolicyREJECT PostTeamSettingsJSONBodyMinReviewersPolicy = "REJECT"
+	PostTeamSettingsJSONBodyMinReviewersPolicyWARN   PostTeamSettingsJSONBodyMinReviewersPolicy = "WARN"
+)
+
+// CreatePullRequestRequest defines model for CreatePullRequestRequest.
+type CreatePullRequestRequest struct {
+	AuthorId string `json:"author_id"`
+
+	// ChangedFiles Изменённые пути; для каждого пути с правилом владения среди ревьюверов будет владелец
+	ChangedFiles *[]string `json:"changed_files,omitempty"`
+	Description  *string   `json:"description,omitempty"`
+
+	// Draft Создать PR в статусе DRAFT; ревьюверы назначаются только после /pullRequest/ready
+	Draft *bool `json:"draft,omitempty"`
+
+	// Labels Метки PR; предпочтение отдаётся ревьюверам с совпадающими навыками
+	Labels *[]string `json:"labels,omitempty"`
+
+	// Number Номер PR в репозитории; если pull_request_id не задан, он формируется как repository#number
+	Number *int `json:"number,omitempty"`
+
+	// PullRequestId Обязателен, если не задан number; вместе с number должен совпадать с repository#number.
+	// Без number не может иметь вид <репозиторий>#<положительное число> (такие ID зарезервированы
+	// за PR с номером, иначе 400); остальные ID, в том числе с '#', принимаются как раньше.
+	PullRequestId   *string `json:"pull_request_id,omitempty"`
+	PullRequestName string  `json:"pull_request_name"`
+
+	// Repository Репозиторий из /repository/add (по умолчанию default)
+	Repository *string   `json:"repository,omitempty"`
+	Size       *DiffSize `json:"size,omitempty"`
+}
+
+// DiffSize defines model for DiffSize.
+type DiffSize struct {
+	FilesChanged int `json:"files_changed"`
+	LinesAdded   int `json:"lines_added"`
+	LinesRemoved int `json:"lines_removed"`
+}
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error struct {
@@ -48,16 +141,65 @@ type ErrorResponse struct {
 // ErrorResponseErrorCode defines model for ErrorResponse.Error.Code.
 type ErrorResponseErrorCode string
 
+// OwnershipRule Правило владения путями; задаётся ровно один из user_id и team_name
+type OwnershipRule struct {
+	// Pattern Glob-шаблон пути (** — любое число каталогов, * и ? — в пределах одного сегмента)
+	Pattern  string  `json:"pattern"`
+	RuleId   int64   `json:"rule_id"`
+	TeamName *string `json:"team_name,omitempty"`
+	UserId   *string `json:"user_id,omitempty"`
+}
+
+// Pairing defines model for Pairing.
+type Pairing struct {
+	AuthorId string `json:"author_id"`
+
+	// Count Число PR автора, на которые был назначен ревьювер
+	Count      int    `json:"count"`
+	ReviewerId string `json:"reviewer_id"`
+}
+
 // PullRequest defines model for PullRequest.
 type PullRequest struct {
-	// AssignedReviewers user_id назначенных ревьюверов (0..2)
-	AssignedReviewers []string          `json:"assigned_reviewers"`
-	AuthorId          string            `json:"author_id"`
-	CreatedAt         *time.Time        `json:"createdAt"`
-	MergedAt          *time.Time        `json:"mergedAt"`
-	PullRequestId     string            `json:"pull_request_id"`
-	PullRequestName   string            `json:"pull_request_name"`
-	Status            PullRequestStatus `json:"status"`
+	// AssignedReviewers user_id назначенных ревьюверов (количество задаётся настройками команды)
+	AssignedReviewers []string   `json:"assigned_reviewers"`
+	AuthorId          string     `json:"author_id"`
+	ClosedAt          *time.Time `json:"closedAt"`
+	CreatedAt         *time.Time `json:"createdAt"`
+	Description       *string    `json:"description,omitempty"`
+
+	// FallbackReviewers Ревьюверы из assigned_reviewers, взятые из резервных команд
+	FallbackReviewers *[]string `json:"fallback_reviewers,omitempty"`
+	Labels            *[]string `json:"labels,omitempty"`
+
+	// MergeOverride PR был слит в обход проверки одобрений
+	MergeOverride *bool      `json:"merge_override,omitempty"`
+	MergedAt      *time.Time `json:"mergedAt"`
+
+	// Number Номер PR в репозитории
+	Number *int `json:"number"`
+
+	// PinnedReviewers Закреплённые ревьюверы; автоматические переназначения их не трогают
+	PinnedReviewers *[]string `json:"pinned_reviewers,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName string    `json:"pull_request_name"`
+
+	// Repository Репозиторий PR
+	Repository *string `json:"repository,omitempty"`
+
+	// ReviewHistory История ревью по раундам, от старых к новым — итоги прошлых раундов, а также решения ревьюверов, снятых или заменённых в текущем раунде и при закрытии PR. История только дополняется — внутри раунда записи идут в порядке сохранения, и один ревьювер может встречаться несколько раз (например, если его сняли и назначили снова или PR закрыли и переоткрыли)
+	ReviewHistory *[]ReviewRound `json:"review_history,omitempty"`
+
+	// ReviewRound Текущий раунд ревью (начинается с 1, увеличивается в /pullRequest/rerequestReview)
+	ReviewRound *int `json:"review_round,omitempty"`
+
+	// Reviews Решения назначенных ревьюверов в текущем раунде
+	Reviews *[]Review         `json:"reviews,omitempty"`
+	Size    *DiffSize         `json:"size,omitempty"`
+	Status  PullRequestStatus `json:"status"`
+
+	// Version Увеличивается при каждом изменении PR; совпадает со значением ETag
+	Version *int `json:"version,omitempty"`
 }
 
 // PullRequestStatus defines model for PullRequest.Status.
@@ -65,51 +207,271 @@ type PullRequestStatus string
 
 // PullRequestShort defines model for PullRequestShort.
 type PullRequestShort struct {
-	AuthorId        string                 `json:"author_id"`
-	PullRequestId   string                 `json:"pull_request_id"`
-	PullRequestName string                 `json:"pull_request_name"`
-	Status          PullRequestShortStatus `json:"status"`
+	AuthorId    string    `json:"author_id"`
+	Description *string   `json:"description,omitempty"`
+	Labels      *[]string `json:"labels,omitempty"`
+
+	// Number Номер PR в репозитории
+	Number          *int   `json:"number"`
+	PullRequestId   string `json:"pull_request_id"`
+	PullRequestName string `json:"pull_request_name"`
+
+	// Repository Репозиторий PR
+	Repository *string                `json:"repository,omitempty"`
+	Size       *DiffSize              `json:"size,omitempty"`
+	Status     PullRequestShortStatus `json:"status"`
+
+	// WaitingRound В /users/getReview — раунд ревью, в котором PR ждёт решения пользователя (null, если решение уже принято)
+	WaitingRound *int `json:"waiting_round"`
 }
 
 // PullRequestShortStatus defines model for PullRequestShort.Status.
 type PullRequestShortStatus string
 
+// Reassignment defines model for Reassignment.
+type Reassignment struct {
+	NewReviewerId *string `json:"new_reviewer_id"`
+	OldReviewerId string  `json:"old_reviewer_id"`
+	PullRequestId string  `json:"pull_request_id"`
+
+	// Reason Код ошибки (как в ErrorResponse), если ревью не удалось перенести
+	Reason *string `json:"reason,omitempty"`
+}
+
+// Repository defines model for Repository.
+type Repository struct {
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+
+	// DefaultTeam Команда, из которой назначаются ревьюверы PR этого репозитория (по умолчанию — команда автора)
+	DefaultTeam *string `json:"default_team,omitempty"`
+
+	// MinReviewers Переопределяет min_reviewers команды
+	MinReviewers   *int   `json:"min_reviewers,omitempty"`
+	RepositoryName string `json:"repository_name"`
+
+	// RequiredApprovals Переопределяет required_approvals команды
+	RequiredApprovals *int `json:"required_approvals,omitempty"`
+
+	// ReviewerCount Переопределяет reviewer_count команды для PR этого репозитория
+	ReviewerCount *int `json:"reviewer_count,omitempty"`
+}
+
+// Review defines model for Review.
+type Review struct {
+	AssignedAt time.Time `json:"assigned_at"`
+
+	// DecidedAt Когда ревьювер принял последнее решение
+	DecidedAt  *time.Time `json:"decided_at"`
+	ReviewerId string     `json:"reviewer_id"`
+
+	// Round Раунд ревью, к которому относится решение
+	Round int         `json:"round"`
+	State ReviewState `json:"state"`
+}
+
+// ReviewState defines model for Review.State.
+type ReviewState string
+
+// ReviewRound defines model for ReviewRound.
+type ReviewRound struct {
+	// Reviews Итоговые решения ревьюверов в этом раунде
+	Reviews []Review `json:"reviews"`
+	Round   int      `json:"round"`
+}
+
+// ReviewerCandidate defines model for ReviewerCandidate.
+type ReviewerCandidate struct {
+	// IsAvailable Кандидат сейчас в рабочих часах (или окажется в них в пределах окна доступности)
+	IsAvailable bool `json:"is_available"`
+
+	// IsFallback Кандидат из резервной команды
+	IsFallback bool `json:"is_fallback"`
+
+	// MatchedSkills Навыки кандидата, совпавшие с метками PR
+	MatchedSkills []string `json:"matched_skills"`
+
+	// MaxOpenReviews Лимит открытых ревью (отсутствует, если лимита нет)
+	MaxOpenReviews *int     `json:"max_open_reviews,omitempty"`
+	OpenReviews    int      `json:"open_reviews"`
+	Reasons        []string `json:"reasons"`
+
+	// RecentPairings Сколько раз кандидат недавно ревьювил автора
+	RecentPairings int `json:"recent_pairings"`
+
+	// Role Уровень пользователя (по умолчанию middle); senior и lead считаются старшими ревьюверами
+	Role UserRole `json:"role"`
+
+	// Score Чем выше, тем лучше кандидат подходит на замену
+	Score    int    `json:"score"`
+	TeamName string `json:"team_name"`
+	UserId   string `json:"user_id"`
+}
+
 // Team defines model for Team.
 type Team struct {
-	Members  []TeamMember `json:"members"`
-	TeamName string       `json:"team_name"`
+	Members []TeamMember `json:"members"`
+
+	// ReviewerStrategy Стратегия выбора ревьюверов (по умолчанию random)
+	ReviewerStrategy *string `json:"reviewer_strategy,omitempty"`
+	TeamName         string  `json:"team_name"`
 }
 
 // TeamMember defines model for TeamMember.
 type TeamMember struct {
-	IsActive bool   `json:"is_active"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Максимум одновременных открытых ревью (null — без ограничения)
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// ReviewWeight Вес при случайном выборе ревьюверов (по умолчанию 1; 0 — не назначать автоматически)
+	ReviewWeight *int `json:"review_weight,omitempty"`
+
+	// Role Уровень пользователя (по умолчанию middle); senior и lead считаются старшими ревьюверами
+	Role *UserRole `json:"role,omitempty"`
+
+	// Skills Навыки пользователя (например go, sql, frontend)
+	Skills   *[]string `json:"skills,omitempty"`
+	UserId   string    `json:"user_id"`
+	Username string    `json:"username"`
 }
 
+// TeamSettings defines model for TeamSettings.
+type TeamSettings struct {
+	// FallbackTeams Команды (в порядке приоритета), из которых добираются ревьюверы, если в своей команде не хватает кандидатов
+	FallbackTeams []string `json:"fallback_teams"`
+
+	// MaxOpenPullRequests Сколько PR в статусе OPEN может быть у одного автора (0 — без ограничения)
+	MaxOpenPullRequests int `json:"max_open_pull_requests"`
+
+	// MinReviewers Минимум ревьюверов, ниже которого срабатывает политика min_reviewers_policy
+	MinReviewers int `json:"min_reviewers"`
+
+	// MinReviewersPolicy WARN — создать PR с предупреждением, REJECT — отклонить создание PR
+	MinReviewersPolicy TeamSettingsMinReviewersPolicy `json:"min_reviewers_policy"`
+
+	// MinSeniorReviewers Сколько назначенных ревьюверов должны быть senior или lead (не больше reviewer_count)
+	MinSeniorReviewers int `json:"min_senior_reviewers"`
+
+	// PairingWindowDays За сколько последних дней учитывать пары автор–ревьювер (0 — не учитывать)
+	PairingWindowDays int `json:"pairing_window_days"`
+
+	// PairingWindowPrs Сколько последних PR автора учитывать, понижая приоритет недавних пар автор–ревьювер (0 — не учитывать)
+	PairingWindowPrs int `json:"pairing_window_prs"`
+
+	// RequiredApprovals Сколько одобрений (APPROVED) нужно для слияния PR; при любом CHANGES_REQUESTED слияние также отклоняется
+	RequiredApprovals int `json:"required_approvals"`
+
+	// ReviewerCount Сколько ревьюверов назначать на новый PR
+	ReviewerCount int    `json:"reviewer_count"`
+	TeamName      string `json:"team_name"`
+}
+
+// TeamSettingsMinReviewersPolicy WARN — создать PR с предупреждением, REJECT — отклонить создание PR
+type TeamSettingsMinReviewersPolicy string
+
 // User defines model for User.
 type User struct {
-	IsActive bool   `json:"is_active"`
-	TeamName string `json:"team_name"`
-	UserId   string `json:"user_id"`
-	Username string `json:"username"`
+	IsActive bool `json:"is_active"`
+
+	// MaxOpenReviews Максимум одновременных открытых ревью (null — без ограничения)
+	MaxOpenReviews *int `json:"max_open_reviews"`
+
+	// ReviewWeight Вес при случайном выборе ревьюверов (0 — не назначать автоматически)
+	ReviewWeight *int `json:"review_weight,omitempty"`
+
+	// Role Уровень пользователя (по умолчанию middle); senior и lead считаются старшими ревьюверами
+	Role     *UserRole `json:"role,omitempty"`
+	Skills   *[]string `json:"skills,omitempty"`
+	TeamName string    `json:"team_name"`
+
+	// Timezone Часовой пояс IANA (например Europe/Moscow)
+	Timezone *string `json:"timezone,omitempty"`
+	UserId   string  `json:"user_id"`
+	Username string  `json:"username"`
+
+	// WorkEnd Конец рабочего дня в формате HH:MM (может быть меньше work_start для ночных смен)
+	WorkEnd *string `json:"work_end,omitempty"`
+
+	// WorkStart Начало рабочего дня в формате HH:MM по времени пользователя
+	WorkStart *string `json:"work_start,omitempty"`
 }
 
+// UserRole Уровень пользователя (по умолчанию middle); senior и lead считаются старшими ревьюверами
+type UserRole string
+
 // TeamNameQuery defines model for TeamNameQuery.
 type TeamNameQuery = string
 
 // UserIdQuery defines model for UserIdQuery.
 type UserIdQuery = string
 
-// PostPullRequestCreateJSONBody defines parameters for PostPullRequestCreate.
-type PostPullRequestCreateJSONBody struct {
-	AuthorId        string `json:"author_id"`
-	PullRequestId   string `json:"pull_request_id"`
-	PullRequestName string `json:"pull_request_name"`
+// PostOwnershipAddJSONBody defines parameters for PostOwnershipAdd.
+type PostOwnershipAddJSONBody struct {
+	Pattern  string  `json:"pattern"`
+	TeamName *string `json:"team_name,omitempty"`
+	UserId   *string `json:"user_id,omitempty"`
+}
+
+// PostOwnershipDeleteJSONBody defines parameters for PostOwnershipDelete.
+type PostOwnershipDeleteJSONBody struct {
+	RuleId int64 `json:"rule_id"`
+}
+
+// PostPullRequestAddReviewerJSONBody defines parameters for PostPullRequestAddReviewer.
+type PostPullRequestAddReviewerJSONBody struct {
+	// Pinned Закрепить ревьювера, чтобы деактивация и ребалансировка его не переносили.
+	// Если поле не передано, новый ревьювер закрепляется, а у уже назначенного закрепление не меняется.
+	Pinned        *bool  `json:"pinned,omitempty"`
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// GetPullRequestCandidatesParams defines parameters for GetPullRequestCandidates.
+type GetPullRequestCandidatesParams struct {
+	PullRequestId string `form:"pull_request_id" json:"pull_request_id"`
+
+	// OldUserId Заменяемый ревьювер; кандидаты берутся из его команды (по умолчанию — из команды автора). Если без него нарушится правило min_senior_reviewers, в списке остаются только senior и lead
+	OldUserId *string `form:"old_user_id,omitempty" json:"old_user_id,omitempty"`
+}
+
+// PostPullRequestCloseJSONBody defines parameters for PostPullRequestClose.
+type PostPullRequestCloseJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestDeclineJSONBody defines parameters for PostPullRequestDecline.
+type PostPullRequestDeclineJSONBody struct {
+	PullRequestId string                               `json:"pull_request_id"`
+	Reason        PostPullRequestDeclineJSONBodyReason `json:"reason"`
+
+	// UserId Отказывающийся ревьювер
+	UserId string `json:"user_id"`
+}
+
+// PostPullRequestDeclineJSONBodyReason defines parameters for PostPullRequestDecline.
+type PostPullRequestDeclineJSONBodyReason string
+
+// GetPullRequestGetParams defines parameters for GetPullRequestGet.
+type GetPullRequestGetParams struct {
+	PullRequestId *string `form:"pull_request_id,omitempty" json:"pull_request_id,omitempty"`
+
+	// Repository Репозиторий PR (по умолчанию default); используется вместе с number
+	Repository *string `form:"repository,omitempty" json:"repository,omitempty"`
+
+	// Number Номер PR в репозитории
+	Number *int `form:"number,omitempty" json:"number,omitempty"`
 }
 
 // PostPullRequestMergeJSONBody defines parameters for PostPullRequestMerge.
 type PostPullRequestMergeJSONBody struct {
+	// Override Слить в обход проверки одобрений (для администраторов)
+	Override       *bool   `json:"override,omitempty"`
+	OverrideReason *string `json:"override_reason,omitempty"`
+	PullRequestId  string  `json:"pull_request_id"`
+}
+
+// PostPullRequestReadyJSONBody defines parameters for PostPullRequestReady.
+type PostPullRequestReadyJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
@@ -119,16 +481,128 @@ type PostPullRequestReassignJSONBody struct {
 	PullRequestId string `json:"pull_request_id"`
 }
 
+// PostPullRequestRemoveReviewerJSONBody defines parameters for PostPullRequestRemoveReviewer.
+type PostPullRequestRemoveReviewerJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+	UserId        string `json:"user_id"`
+}
+
+// PostPullRequestReopenJSONBody defines parameters for PostPullRequestReopen.
+type PostPullRequestReopenJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestRerequestReviewJSONBody defines parameters for PostPullRequestRerequestReview.
+type PostPullRequestRerequestReviewJSONBody struct {
+	PullRequestId string `json:"pull_request_id"`
+}
+
+// PostPullRequestSetLabelsJSONBody defines parameters for PostPullRequestSetLabels.
+type PostPullRequestSetLabelsJSONBody struct {
+	Labels        []string `json:"labels"`
+	PullRequestId string   `json:"pull_request_id"`
+}
+
+// PostPullRequestSetLabelsParams defines parameters for PostPullRequestSetLabels.
+type PostPullRequestSetLabelsParams struct {
+	// IfMatch ETag версии PR, к которой применяется изменение (обязателен, иначе 428)
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// PostPullRequestSubmitReviewJSONBody defines parameters for PostPullRequestSubmitReview.
+type PostPullRequestSubmitReviewJSONBody struct {
+	PullRequestId string                                   `json:"pull_request_id"`
+	State         PostPullRequestSubmitReviewJSONBodyState `json:"state"`
+
+	// UserId Назначенный ревьювер
+	UserId string `json:"user_id"`
+}
+
+// PostPullRequestSubmitReviewJSONBodyState defines parameters for PostPullRequestSubmitReview.
+type PostPullRequestSubmitReviewJSONBodyState string
+
+// PostPullRequestUpdateJSONBody defines parameters for PostPullRequestUpdate.
+type PostPullRequestUpdateJSONBody struct {
+	Description     *string   `json:"description,omitempty"`
+	Labels          *[]string `json:"labels,omitempty"`
+	PullRequestId   string    `json:"pull_request_id"`
+	PullRequestName *string   `json:"pull_request_name,omitempty"`
+	Repository      *string   `json:"repository,omitempty"`
+	Size            *DiffSize `json:"size,omitempty"`
+}
+
+// PostPullRequestUpdateParams defines parameters for PostPullRequestUpdate.
+type PostPullRequestUpdateParams struct {
+	// IfMatch ETag версии PR, к которой применяется изменение (обязателен, иначе 428)
+	IfMatch *string `json:"If-Match,omitempty"`
+}
+
+// GetRepositoryGetParams defines parameters for GetRepositoryGet.
+type GetRepositoryGetParams struct {
+	RepositoryName string `form:"repository_name" json:"repository_name"`
+}
+
+// GetStatsPairingsParams defines parameters for GetStatsPairings.
+type GetStatsPairingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+
+	// Days Учитывать только назначения за последние N дней (по умолчанию — за всё время)
+	Days *int `form:"days,omitempty" json:"days,omitempty"`
+}
+
 // GetTeamGetParams defines parameters for GetTeamGet.
 type GetTeamGetParams struct {
 	// TeamName Уникальное имя команды
 	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
 }
 
+// PostTeamRebalanceJSONBody defines parameters for PostTeamRebalance.
+type PostTeamRebalanceJSONBody struct {
+	DryRun   *bool  `json:"dry_run,omitempty"`
+	TeamName string `json:"team_name"`
+
+	// Threshold Допустимое превышение средней нагрузки (по умолчанию 1)
+	Threshold *int `json:"threshold,omitempty"`
+}
+
+// PostTeamSetReviewerStrategyJSONBody defines parameters for PostTeamSetReviewerStrategy.
+type PostTeamSetReviewerStrategyJSONBody struct {
+	ReviewerStrategy string `json:"reviewer_strategy"`
+	TeamName         string `json:"team_name"`
+}
+
+// GetTeamSettingsParams defines parameters for GetTeamSettings.
+type GetTeamSettingsParams struct {
+	// TeamName Уникальное имя команды
+	TeamName TeamNameQuery `form:"team_name" json:"team_name"`
+}
+
+// PostTeamSettingsJSONBody defines parameters for PostTeamSettings.
+type PostTeamSettingsJSONBody struct {
+	// FallbackTeams Полностью заменяет список резервных команд
+	FallbackTeams       *[]string                                   `json:"fallback_teams,omitempty"`
+	MaxOpenPullRequests *int                                        `json:"max_open_pull_requests,omitempty"`
+	MinReviewers        *int                                        `json:"min_reviewers,omitempty"`
+	MinReviewersPolicy  *PostTeamSettingsJSONBodyMinReviewersPolicy `json:"min_reviewers_policy,omitempty"`
+	MinSeniorReviewers  *int                                        `json:"min_senior_reviewers,omitempty"`
+	PairingWindowDays   *int                                        `json:"pairing_window_days,omitempty"`
+	PairingWindowPrs    *int                                        `json:"pairing_window_prs,omitempty"`
+	RequiredApprovals   *int                                        `json:"required_approvals,omitempty"`
+	ReviewerCount       *int                                        `json:"reviewer_count,omitempty"`
+	TeamName            string                                      `json:"team_name"`
+}
+
+// PostTeamSettingsJSONBodyMinReviewersPolicy defines parameters for PostTeamSettings.
+type PostTeamSettingsJSONBodyMinReviewersPolicy string
+
 // GetUsersGetReviewParams defines parameters for GetUsersGetReview.
 type GetUsersGetReviewParams struct {
 	// UserId Идентификатор пользователя
 	UserId UserIdQuery `form:"user_id" json:"user_id"`
+
+	// Pending Только открытые PR, по которым пользователь ещё не принял решение (state PENDING)
+	Pending *bool `form:"pending,omitempty" json:"pending,omitempty"`
 }
 
 // PostUsersSetIsActiveJSONBody defines parameters for PostUsersSetIsActive.
@@ -137,44 +611,237 @@ type PostUsersSetIsActiveJSONBody struct {
 	UserId   string `json:"user_id"`
 }
 
+// PostUsersSetReviewCapacityJSONBody defines parameters for PostUsersSetReviewCapacity.
+type PostUsersSetReviewCapacityJSONBody struct {
+	// MaxOpenReviews null снимает ограничение
+	MaxOpenReviews *int   `json:"max_open_reviews"`
+	UserId         string `json:"user_id"`
+}
+
+// PostUsersSetReviewWeightJSONBody defines parameters for PostUsersSetReviewWeight.
+type PostUsersSetReviewWeightJSONBody struct {
+	// ReviewWeight 0 — не назначать автоматически (ручное назначение по-прежнему возможно)
+	ReviewWeight int    `json:"review_weight"`
+	UserId       string `json:"user_id"`
+}
+
+// PostUsersSetRoleJSONBody defines parameters for PostUsersSetRole.
+type PostUsersSetRoleJSONBody struct {
+	// Role Уровень пользователя (по умолчанию middle); senior и lead считаются старшими ревьюверами
+	Role   UserRole `json:"role"`
+	UserId string   `json:"user_id"`
+}
+
+// PostUsersSetSkillsJSONBody defines parameters for PostUsersSetSkills.
+type PostUsersSetSkillsJSONBody struct {
+	Skills []string `json:"skills"`
+	UserId string   `json:"user_id"`
+}
+
+// PostUsersSetWorkingHoursJSONBody defines parameters for PostUsersSetWorkingHours.
+type PostUsersSetWorkingHoursJSONBody struct {
+	// Timezone Часовой пояс IANA (по умолчанию UTC)
+	Timezone *string `json:"timezone,omitempty"`
+	UserId   string  `json:"user_id"`
+
+	// WorkEnd HH:MM
+	WorkEnd *string `json:"work_end,omitempty"`
+
+	// WorkStart HH:MM; без work_start и work_end пользователь считается доступным всегда
+	WorkStart *string `json:"work_start,omitempty"`
+}
+
+// PostOwnershipAddJSONRequestBody defines body for PostOwnershipAdd for application/json ContentType.
+type PostOwnershipAddJSONRequestBody PostOwnershipAddJSONBody
+
+// PostOwnershipDeleteJSONRequestBody defines body for PostOwnershipDelete for application/json ContentType.
+type PostOwnershipDeleteJSONRequestBody PostOwnershipDeleteJSONBody
+
+// PostPullRequestAddReviewerJSONRequestBody defines body for PostPullRequestAddReviewer for application/json ContentType.
+type PostPullRequestAddReviewerJSONRequestBody PostPullRequestAddReviewerJSONBody
+
+// PostPullRequestCloseJSONRequestBody defines body for PostPullRequestClose for application/json ContentType.
+type PostPullRequestCloseJSONRequestBody PostPullRequestCloseJSONBody
+
 // PostPullRequestCreateJSONRequestBody defines body for PostPullRequestCreate for application/json ContentType.
-type PostPullRequestCreateJSONRequestBody PostPullRequestCreateJSONBody
+type PostPullRequestCreateJSONRequestBody = CreatePullRequestRequest
+
+// PostPullRequestDeclineJSONRequestBody defines body for PostPullRequestDecline for application/json ContentType.
+type PostPullRequestDeclineJSONRequestBody PostPullRequestDeclineJSONBody
 
 // PostPullRequestMergeJSONRequestBody defines body for PostPullRequestMerge for application/json ContentType.
 type PostPullRequestMergeJSONRequestBody PostPullRequestMergeJSONBody
 
+// PostPullRequestPreviewJSONRequestBody defines body for PostPullRequestPreview for application/json ContentType.
+type PostPullRequestPreviewJSONRequestBody = CreatePullRequestRequest
+
+// PostPullRequestReadyJSONRequestBody defines body for PostPullRequestReady for application/json ContentType.
+type PostPullRequestReadyJSONRequestBody PostPullRequestReadyJSONBody
+
 // PostPullRequestReassignJSONRequestBody defines body for PostPullRequestReassign for application/json ContentType.
 type PostPullRequestReassignJSONRequestBody PostPullRequestReassignJSONBody
 
+// PostPullRequestRemoveReviewerJSONRequestBody defines body for PostPullRequestRemoveReviewer for application/json ContentType.
+type PostPullRequestRemoveReviewerJSONRequestBody PostPullRequestRemoveReviewerJSONBody
+
+// PostPullRequestReopenJSONRequestBody defines body for PostPullRequestReopen for application/json ContentType.
+type PostPullRequestReopenJSONRequestBody PostPullRequestReopenJSONBody
+
+// PostPullRequestRerequestReviewJSONRequestBody defines body for PostPullRequestRerequestReview for application/json ContentType.
+type PostPullRequestRerequestReviewJSONRequestBody PostPullRequestRerequestReviewJSONBody
+
+// PostPullRequestSetLabelsJSONRequestBody defines body for PostPullRequestSetLabels for application/json ContentType.
+type PostPullRequestSetLabelsJSONRequestBody PostPullRequestSetLabelsJSONBody
+
+// PostPullRequestSubmitReviewJSONRequestBody defines body for PostPullRequestSubmitReview for application/json ContentType.
+type PostPullRequestSubmitReviewJSONRequestBody PostPullRequestSubmitReviewJSONBody
+
+// PostPullRequestUpdateJSONRequestBody defines body for PostPullRequestUpdate for application/json ContentType.
+type PostPullRequestUpdateJSONRequestBody PostPullRequestUpdateJSONBody
+
+// PostRepositoryAddJSONRequestBody defines body for PostRepositoryAdd for application/json ContentType.
+type PostRepositoryAddJSONRequestBody = Repository
+
 // PostTeamAddJSONRequestBody defines body for PostTeamAdd for application/json ContentType.
 type PostTeamAddJSONRequestBody = Team
 
+// PostTeamRebalanceJSONRequestBody defines body for PostTeamRebalance for application/json ContentType.
+type PostTeamRebalanceJSONRequestBody PostTeamRebalanceJSONBody
+
+// PostTeamSetReviewerStrategyJSONRequestBody defines body for PostTeamSetReviewerStrategy for application/json ContentType.
+type PostTeamSetReviewerStrategyJSONRequestBody PostTeamSetReviewerStrategyJSONBody
+
+// PostTeamSettingsJSONRequestBody defines body for PostTeamSettings for application/json ContentType.
+type PostTeamSettingsJSONRequestBody PostTeamSettingsJSONBody
+
 // PostUsersSetIsActiveJSONRequestBody defines body for PostUsersSetIsActive for application/json ContentType.
 type PostUsersSetIsActiveJSONRequestBody PostUsersSetIsActiveJSONBody
 
+// PostUsersSetReviewCapacityJSONRequestBody defines body for PostUsersSetReviewCapacity for application/json ContentType.
+type PostUsersSetReviewCapacityJSONRequestBody PostUsersSetReviewCapacityJSONBody
+
+// PostUsersSetReviewWeightJSONRequestBody defines body for PostUsersSetReviewWeight for application/json ContentType.
+type PostUsersSetReviewWeightJSONRequestBody PostUsersSetReviewWeightJSONBody
+
+// PostUsersSetRoleJSONRequestBody defines body for PostUsersSetRole for application/json ContentType.
+type PostUsersSetRoleJSONRequestBody PostUsersSetRoleJSONBody
+
+// PostUsersSetSkillsJSONRequestBody defines body for PostUsersSetSkills for application/json ContentType.
+type PostUsersSetSkillsJSONRequestBody PostUsersSetSkillsJSONBody
+
+// PostUsersSetWorkingHoursJSONRequestBody defines body for PostUsersSetWorkingHours for application/json ContentType.
+type PostUsersSetWorkingHoursJSONRequestBody PostUsersSetWorkingHoursJSONBody
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
+	// Добавить правило владения путями (последнее подходящее правило имеет приоритет)
+	// (POST /ownership/add)
+	PostOwnershipAdd(c *gin.Context)
+	// Удалить правило владения путями
+	// (POST /ownership/delete)
+	PostOwnershipDelete(c *gin.Context)
+	// Получить правила владения путями в порядке применения
+	// (GET /ownership/list)
+	GetOwnershipList(c *gin.Context)
+	// Вручную назначить конкретного ревьювера на открытый PR
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(c *gin.Context)
+	// Ранжированный список кандидатов на замену ревьювера
+	// (GET /pullRequest/candidates)
+	GetPullRequestCandidates(c *gin.Context, params GetPullRequestCandidatesParams)
+	// Закрыть PR без слияния (из DRAFT или OPEN) и снять ревьюверов
+	// (POST /pullRequest/close)
+	PostPullRequestClose(c *gin.Context)
+	// Создать PR и автоматически назначить ревьюверов из команды автора
 	// (POST /pullRequest/create)
 	PostPullRequestCreate(c *gin.Context)
+	// Отказаться от ревью с указанием причины и автоматически подобрать замену
+	// (POST /pullRequest/decline)
+	PostPullRequestDecline(c *gin.Context)
+	// Получить PR с текущей версией в заголовке ETag
+	// (GET /pullRequest/get)
+	GetPullRequestGet(c *gin.Context, params GetPullRequestGetParams)
 	// Пометить PR как MERGED (идемпотентная операция)
 	// (POST /pullRequest/merge)
 	PostPullRequestMerge(c *gin.Context)
+	// Показать, каких ревьюверов получит PR, ничего не сохраняя
+	// (POST /pullRequest/preview)
+	PostPullRequestPreview(c *gin.Context)
+	// Перевести PR из DRAFT в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(c *gin.Context)
 	// Переназначить конкретного ревьювера на другого из его команды
 	// (POST /pullRequest/reassign)
 	PostPullRequestReassign(c *gin.Context)
+	// Вручную снять ревьювера с открытого PR без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(c *gin.Context)
+	// Переоткрыть закрытый PR и заново назначить ревьюверов
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(c *gin.Context)
+	// Запросить повторное ревью у назначенных ревьюверов (новый раунд)
+	// (POST /pullRequest/rerequestReview)
+	PostPullRequestRerequestReview(c *gin.Context)
+	// Заменить набор меток PR (ревьюверы не переназначаются)
+	// (POST /pullRequest/setLabels)
+	PostPullRequestSetLabels(c *gin.Context, params PostPullRequestSetLabelsParams)
+	// Отправить решение ревьювера по PR
+	// (POST /pullRequest/submitReview)
+	PostPullRequestSubmitReview(c *gin.Context)
+	// Изменить название и метаданные PR (ревьюверы не переназначаются)
+	// (POST /pullRequest/update)
+	PostPullRequestUpdate(c *gin.Context, params PostPullRequestUpdateParams)
+	// Добавить репозиторий с командой и настройками по умолчанию
+	// (POST /repository/add)
+	PostRepositoryAdd(c *gin.Context)
+	// Получить репозиторий
+	// (GET /repository/get)
+	GetRepositoryGet(c *gin.Context, params GetRepositoryGetParams)
+	// Получить все репозитории
+	// (GET /repository/list)
+	GetRepositoryList(c *gin.Context)
+	// Матрица назначений автор–ревьювер для авторов команды
+	// (GET /stats/pairings)
+	GetStatsPairings(c *gin.Context, params GetStatsPairingsParams)
 	// Создать команду с участниками (создаёт/обновляет пользователей)
 	// (POST /team/add)
 	PostTeamAdd(c *gin.Context)
 	// Получить команду с участниками
 	// (GET /team/get)
 	GetTeamGet(c *gin.Context, params GetTeamGetParams)
+	// Перераспределить открытые ревью между участниками команды
+	// (POST /team/rebalance)
+	PostTeamRebalance(c *gin.Context)
+	// Установить стратегию выбора ревьюверов для команды
+	// (POST /team/setReviewerStrategy)
+	PostTeamSetReviewerStrategy(c *gin.Context)
+	// Получить настройки назначения ревьюверов команды
+	// (GET /team/settings)
+	GetTeamSettings(c *gin.Context, params GetTeamSettingsParams)
+	// Обновить настройки назначения ревьюверов команды (переданные поля)
+	// (POST /team/settings)
+	PostTeamSettings(c *gin.Context)
 	// Получить PR'ы, где пользователь назначен ревьювером
 	// (GET /users/getReview)
 	GetUsersGetReview(c *gin.Context, params GetUsersGetReviewParams)
 	// Установить флаг активности пользователя
 	// (POST /users/setIsActive)
 	PostUsersSetIsActive(c *gin.Context)
+	// Установить максимум одновременных открытых ревью пользователя
+	// (POST /users/setReviewCapacity)
+	PostUsersSetReviewCapacity(c *gin.Context)
+	// Установить вес пользователя при автоматическом выборе ревьюверов
+	// (POST /users/setReviewWeight)
+	PostUsersSetReviewWeight(c *gin.Context)
+	// Установить уровень пользователя
+	// (POST /users/setRole)
+	PostUsersSetRole(c *gin.Context)
+	// Заменить набор навыков пользователя
+	// (POST /users/setSkills)
+	PostUsersSetSkills(c *gin.Context)
+	// Установить часовой пояс и рабочие часы пользователя
+	// (POST /users/setWorkingHours)
+	PostUsersSetWorkingHours(c *gin.Context)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -186,8 +853,8 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(c *gin.Context)
 
-// PostPullRequestCreate operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
+// PostOwnershipAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostOwnershipAdd(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -196,11 +863,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPullRequestCreate(c)
+	siw.Handler.PostOwnershipAdd(c)
 }
 
-// PostPullRequestMerge operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
+// PostOwnershipDelete operation middleware
+func (siw *ServerInterfaceWrapper) PostOwnershipDelete(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -209,11 +876,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPullRequestMerge(c)
+	siw.Handler.PostOwnershipDelete(c)
 }
 
-// PostPullRequestReassign operation middleware
-func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
+// GetOwnershipList operation middleware
+func (siw *ServerInterfaceWrapper) GetOwnershipList(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -222,11 +889,11 @@ func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostPullRequestReassign(c)
+	siw.Handler.GetOwnershipList(c)
 }
 
-// PostTeamAdd operation middleware
-func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
+// PostPullRequestAddReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestAddReviewer(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -235,29 +902,37 @@ func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostTeamAdd(c)
+	siw.Handler.PostPullRequestAddReviewer(c)
 }
 
-// GetTeamGet operation middleware
-func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
+// GetPullRequestCandidates operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestCandidates(c *gin.Context) {
 
 	var err error
 
 	// Parameter object where we will unmarshal all parameters from the context
-	var params GetTeamGetParams
+	var params GetPullRequestCandidatesParams
 
-	// ------------- Required query parameter "team_name" -------------
+	// ------------- Required query parameter "pull_request_id" -------------
 
-	if paramValue := c.Query("team_name"); paramValue != "" {
+	if paramValue := c.Query("pull_request_id"); paramValue != "" {
 
 	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Query argument pull_request_id is required, but not found"), http.StatusBadRequest)
 		return
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	err = runtime.BindQueryParameter("form", true, true, "pull_request_id", c.Request.URL.Query(), &params.PullRequestId)
 	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pull_request_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "old_user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "old_user_id", c.Request.URL.Query(), &params.OldUserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter old_user_id: %w", err), http.StatusBadRequest)
 		return
 	}
 
@@ -268,31 +943,11 @@ func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetTeamGet(c, params)
+	siw.Handler.GetPullRequestCandidates(c, params)
 }
 
-// GetUsersGetReview operation middleware
-func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetUsersGetReviewParams
-
-	// ------------- Required query parameter "user_id" -------------
-
-	if paramValue := c.Query("user_id"); paramValue != "" {
-
-	} else {
-		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
-		return
-	}
-
-	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
-	if err != nil {
-		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
-		return
-	}
+// PostPullRequestClose operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestClose(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -301,11 +956,11 @@ func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.GetUsersGetReview(c, params)
+	siw.Handler.PostPullRequestClose(c)
 }
 
-// PostUsersSetIsActive operation middleware
-func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
+// PostPullRequestCreate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestCreate(c *gin.Context) {
 
 	for _, middleware := range siw.HandlerMiddlewares {
 		middleware(c)
@@ -314,294 +969,2982 @@ func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
 		}
 	}
 
-	siw.Handler.PostUsersSetIsActive(c)
+	siw.Handler.PostPullRequestCreate(c)
 }
 
-// GinServerOptions provides options for the Gin server.
-type GinServerOptions struct {
-	BaseURL      string
-	Middlewares  []MiddlewareFunc
-	ErrorHandler func(*gin.Context, error, int)
-}
+// PostPullRequestDecline operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestDecline(c *gin.Context) {
 
-// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
-func RegisterHandlers(router gin.IRouter, si ServerInterface) {
-	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestDecline(c)
 }
 
-// RegisterHandlersWithOptions creates http.Handler with additional options
-func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
-	errorHandler := options.ErrorHandler
-	if errorHandler == nil {
-		errorHandler = func(c *gin.Context, err error, statusCode int) {
-			c.JSON(statusCode, gin.H{"msg": err.Error()})
-		}
+// GetPullRequestGet operation middleware
+func (siw *ServerInterfaceWrapper) GetPullRequestGet(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPullRequestGetParams
+
+	// ------------- Optional query parameter "pull_request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pull_request_id", c.Request.URL.Query(), &params.PullRequestId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pull_request_id: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandler:       errorHandler,
+	// ------------- Optional query parameter "repository" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "repository", c.Request.URL.Query(), &params.Repository)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter repository: %w", err), http.StatusBadRequest)
+		return
 	}
 
-	router.POST(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
-	router.POST(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
-	router.POST(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
-	router.POST(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
-	router.GET(options.BaseURL+"/team/get", wrapper.GetTeamGet)
-	router.GET(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
-	router.POST(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
-}
+	// ------------- Optional query parameter "number" -------------
 
-type PostPullRequestCreateRequestObject struct {
-	Body *PostPullRequestCreateJSONRequestBody
-}
+	err = runtime.BindQueryParameter("form", true, false, "number", c.Request.URL.Query(), &params.Number)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter number: %w", err), http.StatusBadRequest)
+		return
+	}
 
-type PostPullRequestCreateResponseObject interface {
-	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostPullRequestCreate201JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
+	siw.Handler.GetPullRequestGet(c, params)
 }
 
-func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// PostPullRequestMerge operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestMerge(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestMerge(c)
 }
 
-type PostPullRequestCreate404JSONResponse ErrorResponse
+// PostPullRequestPreview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestPreview(c *gin.Context) {
 
-func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostPullRequestPreview(c)
 }
 
-type PostPullRequestCreate409JSONResponse ErrorResponse
+// PostPullRequestReady operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReady(c *gin.Context) {
 
-func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostPullRequestReady(c)
 }
 
-type PostPullRequestMergeRequestObject struct {
-	Body *PostPullRequestMergeJSONRequestBody
-}
+// PostPullRequestReassign operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReassign(c *gin.Context) {
 
-type PostPullRequestMergeResponseObject interface {
-	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostPullRequestMerge200JSONResponse struct {
-	Pr *PullRequest `json:"pr,omitempty"`
+	siw.Handler.PostPullRequestReassign(c)
 }
 
-func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// PostPullRequestRemoveReviewer operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestRemoveReviewer(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestRemoveReviewer(c)
 }
 
-type PostPullRequestMerge404JSONResponse ErrorResponse
+// PostPullRequestReopen operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestReopen(c *gin.Context) {
 
-func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostPullRequestReopen(c)
 }
 
-type PostPullRequestReassignRequestObject struct {
-	Body *PostPullRequestReassignJSONRequestBody
-}
+// PostPullRequestRerequestReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestRerequestReview(c *gin.Context) {
 
-type PostPullRequestReassignResponseObject interface {
-	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestRerequestReview(c)
 }
 
-type PostPullRequestReassign200JSONResponse struct {
-	Pr PullRequest `json:"pr"`
+// PostPullRequestSetLabels operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestSetLabels(c *gin.Context) {
 
-	// ReplacedBy user_id нового ревьювера
-	ReplacedBy string `json:"replaced_by"`
-}
+	var err error
 
-func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestSetLabelsParams
 
-	return json.NewEncoder(w).Encode(response)
-}
+	headers := c.Request.Header
 
-type PostPullRequestReassign404JSONResponse ErrorResponse
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
 
-func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
 
-	return json.NewEncoder(w).Encode(response)
-}
+		params.IfMatch = &IfMatch
 
-type PostPullRequestReassign409JSONResponse ErrorResponse
+	}
 
-func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(409)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostPullRequestSetLabels(c, params)
 }
 
-type PostTeamAddRequestObject struct {
-	Body *PostTeamAddJSONRequestBody
-}
+// PostPullRequestSubmitReview operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestSubmitReview(c *gin.Context) {
 
-type PostTeamAddResponseObject interface {
-	VisitPostTeamAddResponse(w http.ResponseWriter) error
-}
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-type PostTeamAdd201JSONResponse struct {
-	Team *Team `json:"team,omitempty"`
+	siw.Handler.PostPullRequestSubmitReview(c)
 }
 
-func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+// PostPullRequestUpdate operation middleware
+func (siw *ServerInterfaceWrapper) PostPullRequestUpdate(c *gin.Context) {
 
-	return json.NewEncoder(w).Encode(response)
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostPullRequestUpdateParams
+
+	headers := c.Request.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch string
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandler(c, fmt.Errorf("Expected one value for If-Match, got %d", n), http.StatusBadRequest)
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter If-Match: %w", err), http.StatusBadRequest)
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostPullRequestUpdate(c, params)
 }
 
-type PostTeamAdd400JSONResponse ErrorResponse
+// PostRepositoryAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostRepositoryAdd(c *gin.Context) {
 
-func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	siw.Handler.PostRepositoryAdd(c)
 }
 
-type GetTeamGetRequestObject struct {
-	Params GetTeamGetParams
+// GetRepositoryGet operation middleware
+func (siw *ServerInterfaceWrapper) GetRepositoryGet(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetRepositoryGetParams
+
+	// ------------- Required query parameter "repository_name" -------------
+
+	if paramValue := c.Query("repository_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument repository_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "repository_name", c.Request.URL.Query(), &params.RepositoryName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter repository_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRepositoryGet(c, params)
+}
+
+// GetRepositoryList operation middleware
+func (siw *ServerInterfaceWrapper) GetRepositoryList(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetRepositoryList(c)
+}
+
+// GetStatsPairings operation middleware
+func (siw *ServerInterfaceWrapper) GetStatsPairings(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatsPairingsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "days" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "days", c.Request.URL.Query(), &params.Days)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter days: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetStatsPairings(c, params)
+}
+
+// PostTeamAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamAdd(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamAdd(c)
+}
+
+// GetTeamGet operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamGet(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamGetParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamGet(c, params)
+}
+
+// PostTeamRebalance operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamRebalance(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamRebalance(c)
+}
+
+// PostTeamSetReviewerStrategy operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSetReviewerStrategy(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamSetReviewerStrategy(c)
+}
+
+// GetTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetTeamSettings(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTeamSettingsParams
+
+	// ------------- Required query parameter "team_name" -------------
+
+	if paramValue := c.Query("team_name"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument team_name is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "team_name", c.Request.URL.Query(), &params.TeamName)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter team_name: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetTeamSettings(c, params)
+}
+
+// PostTeamSettings operation middleware
+func (siw *ServerInterfaceWrapper) PostTeamSettings(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostTeamSettings(c)
+}
+
+// GetUsersGetReview operation middleware
+func (siw *ServerInterfaceWrapper) GetUsersGetReview(c *gin.Context) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetUsersGetReviewParams
+
+	// ------------- Required query parameter "user_id" -------------
+
+	if paramValue := c.Query("user_id"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandler(c, fmt.Errorf("Query argument user_id is required, but not found"), http.StatusBadRequest)
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "user_id", c.Request.URL.Query(), &params.UserId)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter user_id: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	// ------------- Optional query parameter "pending" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "pending", c.Request.URL.Query(), &params.Pending)
+	if err != nil {
+		siw.ErrorHandler(c, fmt.Errorf("Invalid format for parameter pending: %w", err), http.StatusBadRequest)
+		return
+	}
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.GetUsersGetReview(c, params)
+}
+
+// PostUsersSetIsActive operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetIsActive(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetIsActive(c)
+}
+
+// PostUsersSetReviewCapacity operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetReviewCapacity(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetReviewCapacity(c)
+}
+
+// PostUsersSetReviewWeight operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetReviewWeight(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetReviewWeight(c)
+}
+
+// PostUsersSetRole operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetRole(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetRole(c)
+}
+
+// PostUsersSetSkills operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetSkills(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetSkills(c)
+}
+
+// PostUsersSetWorkingHours operation middleware
+func (siw *ServerInterfaceWrapper) PostUsersSetWorkingHours(c *gin.Context) {
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		middleware(c)
+		if c.IsAborted() {
+			return
+		}
+	}
+
+	siw.Handler.PostUsersSetWorkingHours(c)
+}
+
+// GinServerOptions provides options for the Gin server.
+type GinServerOptions struct {
+	BaseURL      string
+	Middlewares  []MiddlewareFunc
+	ErrorHandler func(*gin.Context, error, int)
+}
+
+// RegisterHandlers creates http.Handler with routing matching OpenAPI spec.
+func RegisterHandlers(router gin.IRouter, si ServerInterface) {
+	RegisterHandlersWithOptions(router, si, GinServerOptions{})
+}
+
+// RegisterHandlersWithOptions creates http.Handler with additional options
+func RegisterHandlersWithOptions(router gin.IRouter, si ServerInterface, options GinServerOptions) {
+	errorHandler := options.ErrorHandler
+	if errorHandler == nil {
+		errorHandler = func(c *gin.Context, err error, statusCode int) {
+			c.JSON(statusCode, gin.H{"msg": err.Error()})
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandler:       errorHandler,
+	}
+
+	router.POST(options.BaseURL+"/ownership/add", wrapper.PostOwnershipAdd)
+	router.POST(options.BaseURL+"/ownership/delete", wrapper.PostOwnershipDelete)
+	router.GET(options.BaseURL+"/ownership/list", wrapper.GetOwnershipList)
+	router.POST(options.BaseURL+"/pullRequest/addReviewer", wrapper.PostPullRequestAddReviewer)
+	router.GET(options.BaseURL+"/pullRequest/candidates", wrapper.GetPullRequestCandidates)
+	router.POST(options.BaseURL+"/pullRequest/close", wrapper.PostPullRequestClose)
+	router.POST(options.BaseURL+"/pullRequest/create", wrapper.PostPullRequestCreate)
+	router.POST(options.BaseURL+"/pullRequest/decline", wrapper.PostPullRequestDecline)
+	router.GET(options.BaseURL+"/pullRequest/get", wrapper.GetPullRequestGet)
+	router.POST(options.BaseURL+"/pullRequest/merge", wrapper.PostPullRequestMerge)
+	router.POST(options.BaseURL+"/pullRequest/preview", wrapper.PostPullRequestPreview)
+	router.POST(options.BaseURL+"/pullRequest/ready", wrapper.PostPullRequestReady)
+	router.POST(options.BaseURL+"/pullRequest/reassign", wrapper.PostPullRequestReassign)
+	router.POST(options.BaseURL+"/pullRequest/removeReviewer", wrapper.PostPullRequestRemoveReviewer)
+	router.POST(options.BaseURL+"/pullRequest/reopen", wrapper.PostPullRequestReopen)
+	router.POST(options.BaseURL+"/pullRequest/rerequestReview", wrapper.PostPullRequestRerequestReview)
+	router.POST(options.BaseURL+"/pullRequest/setLabels", wrapper.PostPullRequestSetLabels)
+	router.POST(options.BaseURL+"/pullRequest/submitReview", wrapper.PostPullRequestSubmitReview)
+	router.POST(options.BaseURL+"/pullRequest/update", wrapper.PostPullRequestUpdate)
+	router.POST(options.BaseURL+"/repository/add", wrapper.PostRepositoryAdd)
+	router.GET(options.BaseURL+"/repository/get", wrapper.GetRepositoryGet)
+	router.GET(options.BaseURL+"/repository/list", wrapper.GetRepositoryList)
+	router.GET(options.BaseURL+"/stats/pairings", wrapper.GetStatsPairings)
+	router.POST(options.BaseURL+"/team/add", wrapper.PostTeamAdd)
+	router.GET(options.BaseURL+"/team/get", wrapper.GetTeamGet)
+	router.POST(options.BaseURL+"/team/rebalance", wrapper.PostTeamRebalance)
+	router.POST(options.BaseURL+"/team/setReviewerStrategy", wrapper.PostTeamSetReviewerStrategy)
+	router.GET(options.BaseURL+"/team/settings", wrapper.GetTeamSettings)
+	router.POST(options.BaseURL+"/team/settings", wrapper.PostTeamSettings)
+	router.GET(options.BaseURL+"/users/getReview", wrapper.GetUsersGetReview)
+	router.POST(options.BaseURL+"/users/setIsActive", wrapper.PostUsersSetIsActive)
+	router.POST(options.BaseURL+"/users/setReviewCapacity", wrapper.PostUsersSetReviewCapacity)
+	router.POST(options.BaseURL+"/users/setReviewWeight", wrapper.PostUsersSetReviewWeight)
+	router.POST(options.BaseURL+"/users/setRole", wrapper.PostUsersSetRole)
+	router.POST(options.BaseURL+"/users/setSkills", wrapper.PostUsersSetSkills)
+	router.POST(options.BaseURL+"/users/setWorkingHours", wrapper.PostUsersSetWorkingHours)
+}
+
+type PostOwnershipAddRequestObject struct {
+	Body *PostOwnershipAddJSONRequestBody
+}
+
+type PostOwnershipAddResponseObject interface {
+	VisitPostOwnershipAddResponse(w http.ResponseWriter) error
+}
+
+type PostOwnershipAdd201JSONResponse struct {
+	// Rule Правило владения путями; задаётся ровно один из user_id и team_name
+	Rule *OwnershipRule `json:"rule,omitempty"`
+}
+
+func (response PostOwnershipAdd201JSONResponse) VisitPostOwnershipAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOwnershipAdd400JSONResponse ErrorResponse
+
+func (response PostOwnershipAdd400JSONResponse) VisitPostOwnershipAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOwnershipAdd404JSONResponse ErrorResponse
+
+func (response PostOwnershipAdd404JSONResponse) VisitPostOwnershipAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOwnershipDeleteRequestObject struct {
+	Body *PostOwnershipDeleteJSONRequestBody
+}
+
+type PostOwnershipDeleteResponseObject interface {
+	VisitPostOwnershipDeleteResponse(w http.ResponseWriter) error
+}
+
+type PostOwnershipDelete200JSONResponse struct {
+	RuleId *int64 `json:"rule_id,omitempty"`
+}
+
+func (response PostOwnershipDelete200JSONResponse) VisitPostOwnershipDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostOwnershipDelete404JSONResponse ErrorResponse
+
+func (response PostOwnershipDelete404JSONResponse) VisitPostOwnershipDeleteResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOwnershipListRequestObject struct {
+}
+
+type GetOwnershipListResponseObject interface {
+	VisitGetOwnershipListResponse(w http.ResponseWriter) error
+}
+
+type GetOwnershipList200JSONResponse struct {
+	Rules []OwnershipRule `json:"rules"`
+}
+
+func (response GetOwnershipList200JSONResponse) VisitGetOwnershipListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewerRequestObject struct {
+	Body *PostPullRequestAddReviewerJSONRequestBody
+}
+
+type PostPullRequestAddReviewerResponseObject interface {
+	VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestAddReviewer200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestAddReviewer200JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer400JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer400JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer404JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestAddReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestAddReviewer409JSONResponse) VisitPostPullRequestAddReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestCandidatesRequestObject struct {
+	Params GetPullRequestCandidatesParams
+}
+
+type GetPullRequestCandidatesResponseObject interface {
+	VisitGetPullRequestCandidatesResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestCandidates200JSONResponse struct {
+	Candidates    []ReviewerCandidate `json:"candidates"`
+	PullRequestId string              `json:"pull_request_id"`
+}
+
+func (response GetPullRequestCandidates200JSONResponse) VisitGetPullRequestCandidatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestCandidates404JSONResponse ErrorResponse
+
+func (response GetPullRequestCandidates404JSONResponse) VisitGetPullRequestCandidatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestCandidates409JSONResponse ErrorResponse
+
+func (response GetPullRequestCandidates409JSONResponse) VisitGetPullRequestCandidatesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCloseRequestObject struct {
+	Body *PostPullRequestCloseJSONRequestBody
+}
+
+type PostPullRequestCloseResponseObject interface {
+	VisitPostPullRequestCloseResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestClose200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestClose200JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose404JSONResponse ErrorResponse
+
+func (response PostPullRequestClose404JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestClose409JSONResponse ErrorResponse
+
+func (response PostPullRequestClose409JSONResponse) VisitPostPullRequestCloseResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreateRequestObject struct {
+	Body *PostPullRequestCreateJSONRequestBody
+}
+
+type PostPullRequestCreateResponseObject interface {
+	VisitPostPullRequestCreateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestCreate201JSONResponse struct {
+	Pr       *PullRequest `json:"pr,omitempty"`
+	Warnings *[]string    `json:"warnings,omitempty"`
+}
+
+func (response PostPullRequestCreate201JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate404JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate404JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestCreate409JSONResponse ErrorResponse
+
+func (response PostPullRequestCreate409JSONResponse) VisitPostPullRequestCreateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDeclineRequestObject struct {
+	Body *PostPullRequestDeclineJSONRequestBody
+}
+
+type PostPullRequestDeclineResponseObject interface {
+	VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestDecline200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// Reason Почему замена не найдена (NO_CANDIDATE или AT_CAPACITY)
+	Reason *string `json:"reason,omitempty"`
+
+	// ReplacedBy user_id нового ревьювера (null, если замены нет)
+	ReplacedBy *string `json:"replaced_by"`
+}
+
+func (response PostPullRequestDecline200JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDecline400JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline400JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDecline404JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline404JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestDecline409JSONResponse ErrorResponse
+
+func (response PostPullRequestDecline409JSONResponse) VisitPostPullRequestDeclineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGetRequestObject struct {
+	Params GetPullRequestGetParams
+}
+
+type GetPullRequestGetResponseObject interface {
+	VisitGetPullRequestGetResponse(w http.ResponseWriter) error
+}
+
+type GetPullRequestGet200ResponseHeaders struct {
+	ETag string
+}
+
+type GetPullRequestGet200JSONResponse struct {
+	Body struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	Headers GetPullRequestGet200ResponseHeaders
+}
+
+func (response GetPullRequestGet200JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type GetPullRequestGet400JSONResponse ErrorResponse
+
+func (response GetPullRequestGet400JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPullRequestGet404JSONResponse ErrorResponse
+
+func (response GetPullRequestGet404JSONResponse) VisitGetPullRequestGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMergeRequestObject struct {
+	Body *PostPullRequestMergeJSONRequestBody
+}
+
+type PostPullRequestMergeResponseObject interface {
+	VisitPostPullRequestMergeResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestMerge200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestMerge200JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge404JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge404JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestMerge409JSONResponse ErrorResponse
+
+func (response PostPullRequestMerge409JSONResponse) VisitPostPullRequestMergeResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestPreviewRequestObject struct {
+	Body *PostPullRequestPreviewJSONRequestBody
+}
+
+type PostPullRequestPreviewResponseObject interface {
+	VisitPostPullRequestPreviewResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestPreview200JSONResponse struct {
+	Pr       *PullRequest `json:"pr,omitempty"`
+	Warnings *[]string    `json:"warnings,omitempty"`
+}
+
+func (response PostPullRequestPreview200JSONResponse) VisitPostPullRequestPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestPreview404JSONResponse ErrorResponse
+
+func (response PostPullRequestPreview404JSONResponse) VisitPostPullRequestPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestPreview409JSONResponse ErrorResponse
+
+func (response PostPullRequestPreview409JSONResponse) VisitPostPullRequestPreviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReadyRequestObject struct {
+	Body *PostPullRequestReadyJSONRequestBody
+}
+
+type PostPullRequestReadyResponseObject interface {
+	VisitPostPullRequestReadyResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReady200JSONResponse struct {
+	Pr       *PullRequest `json:"pr,omitempty"`
+	Warnings *[]string    `json:"warnings,omitempty"`
+}
+
+func (response PostPullRequestReady200JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady404JSONResponse ErrorResponse
+
+func (response PostPullRequestReady404JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReady409JSONResponse ErrorResponse
+
+func (response PostPullRequestReady409JSONResponse) VisitPostPullRequestReadyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassignRequestObject struct {
+	Body *PostPullRequestReassignJSONRequestBody
+}
+
+type PostPullRequestReassignResponseObject interface {
+	VisitPostPullRequestReassignResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReassign200JSONResponse struct {
+	Pr PullRequest `json:"pr"`
+
+	// ReplacedBy user_id нового ревьювера
+	ReplacedBy string `json:"replaced_by"`
+}
+
+func (response PostPullRequestReassign200JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign404JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign404JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReassign409JSONResponse ErrorResponse
+
+func (response PostPullRequestReassign409JSONResponse) VisitPostPullRequestReassignResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewerRequestObject struct {
+	Body *PostPullRequestRemoveReviewerJSONRequestBody
+}
+
+type PostPullRequestRemoveReviewerResponseObject interface {
+	VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestRemoveReviewer200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestRemoveReviewer200JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer404JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer404JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRemoveReviewer409JSONResponse ErrorResponse
+
+func (response PostPullRequestRemoveReviewer409JSONResponse) VisitPostPullRequestRemoveReviewerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopenRequestObject struct {
+	Body *PostPullRequestReopenJSONRequestBody
+}
+
+type PostPullRequestReopenResponseObject interface {
+	VisitPostPullRequestReopenResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestReopen200JSONResponse struct {
+	Pr       *PullRequest `json:"pr,omitempty"`
+	Warnings *[]string    `json:"warnings,omitempty"`
+}
+
+func (response PostPullRequestReopen200JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen404JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen404JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestReopen409JSONResponse ErrorResponse
+
+func (response PostPullRequestReopen409JSONResponse) VisitPostPullRequestReopenResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRerequestReviewRequestObject struct {
+	Body *PostPullRequestRerequestReviewJSONRequestBody
+}
+
+type PostPullRequestRerequestReviewResponseObject interface {
+	VisitPostPullRequestRerequestReviewResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestRerequestReview200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestRerequestReview200JSONResponse) VisitPostPullRequestRerequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRerequestReview404JSONResponse ErrorResponse
+
+func (response PostPullRequestRerequestReview404JSONResponse) VisitPostPullRequestRerequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestRerequestReview409JSONResponse ErrorResponse
+
+func (response PostPullRequestRerequestReview409JSONResponse) VisitPostPullRequestRerequestReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetLabelsRequestObject struct {
+	Params PostPullRequestSetLabelsParams
+	Body   *PostPullRequestSetLabelsJSONRequestBody
+}
+
+type PostPullRequestSetLabelsResponseObject interface {
+	VisitPostPullRequestSetLabelsResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestSetLabels200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestSetLabels200JSONResponse struct {
+	Body struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	Headers PostPullRequestSetLabels200ResponseHeaders
+}
+
+func (response PostPullRequestSetLabels200JSONResponse) VisitPostPullRequestSetLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestSetLabels404JSONResponse ErrorResponse
+
+func (response PostPullRequestSetLabels404JSONResponse) VisitPostPullRequestSetLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetLabels409JSONResponse ErrorResponse
+
+func (response PostPullRequestSetLabels409JSONResponse) VisitPostPullRequestSetLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetLabels412JSONResponse ErrorResponse
+
+func (response PostPullRequestSetLabels412JSONResponse) VisitPostPullRequestSetLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSetLabels428JSONResponse ErrorResponse
+
+func (response PostPullRequestSetLabels428JSONResponse) VisitPostPullRequestSetLabelsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSubmitReviewRequestObject struct {
+	Body *PostPullRequestSubmitReviewJSONRequestBody
+}
+
+type PostPullRequestSubmitReviewResponseObject interface {
+	VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestSubmitReview200JSONResponse struct {
+	Pr *PullRequest `json:"pr,omitempty"`
+}
+
+func (response PostPullRequestSubmitReview200JSONResponse) VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSubmitReview400JSONResponse ErrorResponse
+
+func (response PostPullRequestSubmitReview400JSONResponse) VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSubmitReview404JSONResponse ErrorResponse
+
+func (response PostPullRequestSubmitReview404JSONResponse) VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestSubmitReview409JSONResponse ErrorResponse
+
+func (response PostPullRequestSubmitReview409JSONResponse) VisitPostPullRequestSubmitReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdateRequestObject struct {
+	Params PostPullRequestUpdateParams
+	Body   *PostPullRequestUpdateJSONRequestBody
+}
+
+type PostPullRequestUpdateResponseObject interface {
+	VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error
+}
+
+type PostPullRequestUpdate200ResponseHeaders struct {
+	ETag string
+}
+
+type PostPullRequestUpdate200JSONResponse struct {
+	Body struct {
+		Pr *PullRequest `json:"pr,omitempty"`
+	}
+	Headers PostPullRequestUpdate200ResponseHeaders
+}
+
+func (response PostPullRequestUpdate200JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type PostPullRequestUpdate400JSONResponse ErrorResponse
+
+func (response PostPullRequestUpdate400JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate404JSONResponse ErrorResponse
+
+func (response PostPullRequestUpdate404JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate409JSONResponse ErrorResponse
+
+func (response PostPullRequestUpdate409JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate412JSONResponse ErrorResponse
+
+func (response PostPullRequestUpdate412JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostPullRequestUpdate428JSONResponse ErrorResponse
+
+func (response PostPullRequestUpdate428JSONResponse) VisitPostPullRequestUpdateResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(428)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryAddRequestObject struct {
+	Body *PostRepositoryAddJSONRequestBody
+}
+
+type PostRepositoryAddResponseObject interface {
+	VisitPostRepositoryAddResponse(w http.ResponseWriter) error
+}
+
+type PostRepositoryAdd201JSONResponse struct {
+	Repository *Repository `json:"repository,omitempty"`
+}
+
+func (response PostRepositoryAdd201JSONResponse) VisitPostRepositoryAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryAdd400JSONResponse ErrorResponse
+
+func (response PostRepositoryAdd400JSONResponse) VisitPostRepositoryAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryAdd404JSONResponse ErrorResponse
+
+func (response PostRepositoryAdd404JSONResponse) VisitPostRepositoryAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostRepositoryAdd409JSONResponse ErrorResponse
+
+func (response PostRepositoryAdd409JSONResponse) VisitPostRepositoryAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryGetRequestObject struct {
+	Params GetRepositoryGetParams
+}
+
+type GetRepositoryGetResponseObject interface {
+	VisitGetRepositoryGetResponse(w http.ResponseWriter) error
+}
+
+type GetRepositoryGet200JSONResponse struct {
+	Repository *Repository `json:"repository,omitempty"`
+}
+
+func (response GetRepositoryGet200JSONResponse) VisitGetRepositoryGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryGet404JSONResponse ErrorResponse
+
+func (response GetRepositoryGet404JSONResponse) VisitGetRepositoryGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetRepositoryListRequestObject struct {
+}
+
+type GetRepositoryListResponseObject interface {
+	VisitGetRepositoryListResponse(w http.ResponseWriter) error
+}
+
+type GetRepositoryList200JSONResponse struct {
+	Repositories []Repository `json:"repositories"`
+}
+
+func (response GetRepositoryList200JSONResponse) VisitGetRepositoryListResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsPairingsRequestObject struct {
+	Params GetStatsPairingsParams
+}
+
+type GetStatsPairingsResponseObject interface {
+	VisitGetStatsPairingsResponse(w http.ResponseWriter) error
+}
+
+type GetStatsPairings200JSONResponse struct {
+	Days     *int      `json:"days,omitempty"`
+	Pairings []Pairing `json:"pairings"`
+	TeamName string    `json:"team_name"`
+}
+
+func (response GetStatsPairings200JSONResponse) VisitGetStatsPairingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatsPairings404JSONResponse ErrorResponse
+
+func (response GetStatsPairings404JSONResponse) VisitGetStatsPairingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAddRequestObject struct {
+	Body *PostTeamAddJSONRequestBody
+}
+
+type PostTeamAddResponseObject interface {
+	VisitPostTeamAddResponse(w http.ResponseWriter) error
+}
+
+type PostTeamAdd201JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamAdd201JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamAdd400JSONResponse ErrorResponse
+
+func (response PostTeamAdd400JSONResponse) VisitPostTeamAddResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGetRequestObject struct {
+	Params GetTeamGetParams
 }
 
 type GetTeamGetResponseObject interface {
 	VisitGetTeamGetResponse(w http.ResponseWriter) error
 }
 
-type GetTeamGet200JSONResponse Team
-
-func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type GetTeamGet200JSONResponse Team
+
+func (response GetTeamGet200JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamGet404JSONResponse ErrorResponse
+
+func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRebalanceRequestObject struct {
+	Body *PostTeamRebalanceJSONRequestBody
+}
+
+type PostTeamRebalanceResponseObject interface {
+	VisitPostTeamRebalanceResponse(w http.ResponseWriter) error
+}
+
+type PostTeamRebalance200JSONResponse struct {
+	Average       float32        `json:"average"`
+	DryRun        bool           `json:"dry_run"`
+	Reassignments []Reassignment `json:"reassignments"`
+	TeamName      string         `json:"team_name"`
+	Threshold     int            `json:"threshold"`
+}
+
+func (response PostTeamRebalance200JSONResponse) VisitPostTeamRebalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRebalance400JSONResponse ErrorResponse
+
+func (response PostTeamRebalance400JSONResponse) VisitPostTeamRebalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamRebalance404JSONResponse ErrorResponse
+
+func (response PostTeamRebalance404JSONResponse) VisitPostTeamRebalanceResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewerStrategyRequestObject struct {
+	Body *PostTeamSetReviewerStrategyJSONRequestBody
+}
+
+type PostTeamSetReviewerStrategyResponseObject interface {
+	VisitPostTeamSetReviewerStrategyResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSetReviewerStrategy200JSONResponse struct {
+	Team *Team `json:"team,omitempty"`
+}
+
+func (response PostTeamSetReviewerStrategy200JSONResponse) VisitPostTeamSetReviewerStrategyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewerStrategy400JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewerStrategy400JSONResponse) VisitPostTeamSetReviewerStrategyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSetReviewerStrategy404JSONResponse ErrorResponse
+
+func (response PostTeamSetReviewerStrategy404JSONResponse) VisitPostTeamSetReviewerStrategyResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettingsRequestObject struct {
+	Params GetTeamSettingsParams
+}
+
+type GetTeamSettingsResponseObject interface {
+	VisitGetTeamSettingsResponse(w http.ResponseWriter) error
+}
+
+type GetTeamSettings200JSONResponse TeamSettings
+
+func (response GetTeamSettings200JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetTeamSettings404JSONResponse ErrorResponse
+
+func (response GetTeamSettings404JSONResponse) VisitGetTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettingsRequestObject struct {
+	Body *PostTeamSettingsJSONRequestBody
+}
+
+type PostTeamSettingsResponseObject interface {
+	VisitPostTeamSettingsResponse(w http.ResponseWriter) error
+}
+
+type PostTeamSettings200JSONResponse struct {
+	Settings *TeamSettings `json:"settings,omitempty"`
+}
+
+func (response PostTeamSettings200JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings400JSONResponse ErrorResponse
+
+func (response PostTeamSettings400JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostTeamSettings404JSONResponse ErrorResponse
+
+func (response PostTeamSettings404JSONResponse) VisitPostTeamSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetUsersGetReviewRequestObject struct {
+	Params GetUsersGetReviewParams
+}
+
+type GetUsersGetReviewResponseObject interface {
+	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
+}
+
+type GetUsersGetReview200JSONResponse struct {
+	PullRequests []PullRequestShort `json:"pull_requests"`
+	UserId       string             `json:"user_id"`
+}
+
+func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActiveRequestObject struct {
+	Body *PostUsersSetIsActiveJSONRequestBody
+}
+
+type PostUsersSetIsActiveResponseObject interface {
+	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetIsActive200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetIsActive404JSONResponse ErrorResponse
+
+func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetReviewCapacityRequestObject struct {
+	Body *PostUsersSetReviewCapacityJSONRequestBody
+}
+
+type PostUsersSetReviewCapacityResponseObject interface {
+	VisitPostUsersSetReviewCapacityResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetReviewCapacity200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetReviewCapacity200JSONResponse) VisitPostUsersSetReviewCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetReviewCapacity400JSONResponse ErrorResponse
+
+func (response PostUsersSetReviewCapacity400JSONResponse) VisitPostUsersSetReviewCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetReviewCapacity404JSONResponse ErrorResponse
+
+func (response PostUsersSetReviewCapacity404JSONResponse) VisitPostUsersSetReviewCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetReviewWeightRequestObject struct {
+	Body *PostUsersSetReviewWeightJSONRequestBody
+}
+
+type PostUsersSetReviewWeightResponseObject interface {
+	VisitPostUsersSetReviewWeightResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetReviewWeight200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetReviewWeight200JSONResponse) VisitPostUsersSetReviewWeightResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetReviewWeight400JSONResponse ErrorResponse
+
+func (response PostUsersSetReviewWeight400JSONResponse) VisitPostUsersSetReviewWeightResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetReviewWeight404JSONResponse ErrorResponse
+
+func (response PostUsersSetReviewWeight404JSONResponse) VisitPostUsersSetReviewWeightResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetRoleRequestObject struct {
+	Body *PostUsersSetRoleJSONRequestBody
+}
+
+type PostUsersSetRoleResponseObject interface {
+	VisitPostUsersSetRoleResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetRole200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetRole200JSONResponse) VisitPostUsersSetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetRole400JSONResponse ErrorResponse
+
+func (response PostUsersSetRole400JSONResponse) VisitPostUsersSetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetRole404JSONResponse ErrorResponse
+
+func (response PostUsersSetRole404JSONResponse) VisitPostUsersSetRoleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSkillsRequestObject struct {
+	Body *PostUsersSetSkillsJSONRequestBody
+}
+
+type PostUsersSetSkillsResponseObject interface {
+	VisitPostUsersSetSkillsResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetSkills200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetSkills200JSONResponse) VisitPostUsersSetSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetSkills404JSONResponse ErrorResponse
+
+func (response PostUsersSetSkills404JSONResponse) VisitPostUsersSetSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetWorkingHoursRequestObject struct {
+	Body *PostUsersSetWorkingHoursJSONRequestBody
+}
+
+type PostUsersSetWorkingHoursResponseObject interface {
+	VisitPostUsersSetWorkingHoursResponse(w http.ResponseWriter) error
+}
+
+type PostUsersSetWorkingHours200JSONResponse struct {
+	User *User `json:"user,omitempty"`
+}
+
+func (response PostUsersSetWorkingHours200JSONResponse) VisitPostUsersSetWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetWorkingHours400JSONResponse ErrorResponse
+
+func (response PostUsersSetWorkingHours400JSONResponse) VisitPostUsersSetWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostUsersSetWorkingHours404JSONResponse ErrorResponse
+
+func (response PostUsersSetWorkingHours404JSONResponse) VisitPostUsersSetWorkingHoursResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Добавить правило владения путями (последнее подходящее правило имеет приоритет)
+	// (POST /ownership/add)
+	PostOwnershipAdd(ctx context.Context, request PostOwnershipAddRequestObject) (PostOwnershipAddResponseObject, error)
+	// Удалить правило владения путями
+	// (POST /ownership/delete)
+	PostOwnershipDelete(ctx context.Context, request PostOwnershipDeleteRequestObject) (PostOwnershipDeleteResponseObject, error)
+	// Получить правила владения путями в порядке применения
+	// (GET /ownership/list)
+	GetOwnershipList(ctx context.Context, request GetOwnershipListRequestObject) (GetOwnershipListResponseObject, error)
+	// Вручную назначить конкретного ревьювера на открытый PR
+	// (POST /pullRequest/addReviewer)
+	PostPullRequestAddReviewer(ctx context.Context, request PostPullRequestAddReviewerRequestObject) (PostPullRequestAddReviewerResponseObject, error)
+	// Ранжированный список кандидатов на замену ревьювера
+	// (GET /pullRequest/candidates)
+	GetPullRequestCandidates(ctx context.Context, request GetPullRequestCandidatesRequestObject) (GetPullRequestCandidatesResponseObject, error)
+	// Закрыть PR без слияния (из DRAFT или OPEN) и снять ревьюверов
+	// (POST /pullRequest/close)
+	PostPullRequestClose(ctx context.Context, request PostPullRequestCloseRequestObject) (PostPullRequestCloseResponseObject, error)
+	// Создать PR и автоматически назначить ревьюверов из команды автора
+	// (POST /pullRequest/create)
+	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
+	// Отказаться от ревью с указанием причины и автоматически подобрать замену
+	// (POST /pullRequest/decline)
+	PostPullRequestDecline(ctx context.Context, request PostPullRequestDeclineRequestObject) (PostPullRequestDeclineResponseObject, error)
+	// Получить PR с текущей версией в заголовке ETag
+	// (GET /pullRequest/get)
+	GetPullRequestGet(ctx context.Context, request GetPullRequestGetRequestObject) (GetPullRequestGetResponseObject, error)
+	// Пометить PR как MERGED (идемпотентная операция)
+	// (POST /pullRequest/merge)
+	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
+	// Показать, каких ревьюверов получит PR, ничего не сохраняя
+	// (POST /pullRequest/preview)
+	PostPullRequestPreview(ctx context.Context, request PostPullRequestPreviewRequestObject) (PostPullRequestPreviewResponseObject, error)
+	// Перевести PR из DRAFT в OPEN и назначить ревьюверов
+	// (POST /pullRequest/ready)
+	PostPullRequestReady(ctx context.Context, request PostPullRequestReadyRequestObject) (PostPullRequestReadyResponseObject, error)
+	// Переназначить конкретного ревьювера на другого из его команды
+	// (POST /pullRequest/reassign)
+	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
+	// Вручную снять ревьювера с открытого PR без замены
+	// (POST /pullRequest/removeReviewer)
+	PostPullRequestRemoveReviewer(ctx context.Context, request PostPullRequestRemoveReviewerRequestObject) (PostPullRequestRemoveReviewerResponseObject, error)
+	// Переоткрыть закрытый PR и заново назначить ревьюверов
+	// (POST /pullRequest/reopen)
+	PostPullRequestReopen(ctx context.Context, request PostPullRequestReopenRequestObject) (PostPullRequestReopenResponseObject, error)
+	// Запросить повторное ревью у назначенных ревьюверов (новый раунд)
+	// (POST /pullRequest/rerequestReview)
+	PostPullRequestRerequestReview(ctx context.Context, request PostPullRequestRerequestReviewRequestObject) (PostPullRequestRerequestReviewResponseObject, error)
+	// Заменить набор меток PR (ревьюверы не переназначаются)
+	// (POST /pullRequest/setLabels)
+	PostPullRequestSetLabels(ctx context.Context, request PostPullRequestSetLabelsRequestObject) (PostPullRequestSetLabelsResponseObject, error)
+	// Отправить решение ревьювера по PR
+	// (POST /pullRequest/submitReview)
+	PostPullRequestSubmitReview(ctx context.Context, request PostPullRequestSubmitReviewRequestObject) (PostPullRequestSubmitReviewResponseObject, error)
+	// Изменить название и метаданные PR (ревьюверы не переназначаются)
+	// (POST /pullRequest/update)
+	PostPullRequestUpdate(ctx context.Context, request PostPullRequestUpdateRequestObject) (PostPullRequestUpdateResponseObject, error)
+	// Добавить репозиторий с командой и настройками по умолчанию
+	// (POST /repository/add)
+	PostRepositoryAdd(ctx context.Context, request PostRepositoryAddRequestObject) (PostRepositoryAddResponseObject, error)
+	// Получить репозиторий
+	// (GET /repository/get)
+	GetRepositoryGet(ctx context.Context, request GetRepositoryGetRequestObject) (GetRepositoryGetResponseObject, error)
+	// Получить все репозитории
+	// (GET /repository/list)
+	GetRepositoryList(ctx context.Context, request GetRepositoryListRequestObject) (GetRepositoryListResponseObject, error)
+	// Матрица назначений автор–ревьювер для авторов команды
+	// (GET /stats/pairings)
+	GetStatsPairings(ctx context.Context, request GetStatsPairingsRequestObject) (GetStatsPairingsResponseObject, error)
+	// Создать команду с участниками (создаёт/обновляет пользователей)
+	// (POST /team/add)
+	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
+	// Получить команду с участниками
+	// (GET /team/get)
+	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
+	// Перераспределить открытые ревью между участниками команды
+	// (POST /team/rebalance)
+	PostTeamRebalance(ctx context.Context, request PostTeamRebalanceRequestObject) (PostTeamRebalanceResponseObject, error)
+	// Установить стратегию выбора ревьюверов для команды
+	// (POST /team/setReviewerStrategy)
+	PostTeamSetReviewerStrategy(ctx context.Context, request PostTeamSetReviewerStrategyRequestObject) (PostTeamSetReviewerStrategyResponseObject, error)
+	// Получить настройки назначения ревьюверов команды
+	// (GET /team/settings)
+	GetTeamSettings(ctx context.Context, request GetTeamSettingsRequestObject) (GetTeamSettingsResponseObject, error)
+	// Обновить настройки назначения ревьюверов команды (переданные поля)
+	// (POST /team/settings)
+	PostTeamSettings(ctx context.Context, request PostTeamSettingsRequestObject) (PostTeamSettingsResponseObject, error)
+	// Получить PR'ы, где пользователь назначен ревьювером
+	// (GET /users/getReview)
+	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
+	// Установить флаг активности пользователя
+	// (POST /users/setIsActive)
+	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
+	// Установить максимум одновременных открытых ревью пользователя
+	// (POST /users/setReviewCapacity)
+	PostUsersSetReviewCapacity(ctx context.Context, request PostUsersSetReviewCapacityRequestObject) (PostUsersSetReviewCapacityResponseObject, error)
+	// Установить вес пользователя при автоматическом выборе ревьюверов
+	// (POST /users/setReviewWeight)
+	PostUsersSetReviewWeight(ctx context.Context, request PostUsersSetReviewWeightRequestObject) (PostUsersSetReviewWeightResponseObject, error)
+	// Установить уровень пользователя
+	// (POST /users/setRole)
+	PostUsersSetRole(ctx context.Context, request PostUsersSetRoleRequestObject) (PostUsersSetRoleResponseObject, error)
+	// Заменить набор навыков пользователя
+	// (POST /users/setSkills)
+	PostUsersSetSkills(ctx context.Context, request PostUsersSetSkillsRequestObject) (PostUsersSetSkillsResponseObject, error)
+	// Установить часовой пояс и рабочие часы пользователя
+	// (POST /users/setWorkingHours)
+	PostUsersSetWorkingHours(ctx context.Context, request PostUsersSetWorkingHoursRequestObject) (PostUsersSetWorkingHoursResponseObject, error)
+}
+
+type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
+type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+}
+
+// PostOwnershipAdd operation middleware
+func (sh *strictHandler) PostOwnershipAdd(ctx *gin.Context) {
+	var request PostOwnershipAddRequestObject
+
+	var body PostOwnershipAddJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOwnershipAdd(ctx, request.(PostOwnershipAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOwnershipAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostOwnershipAddResponseObject); ok {
+		if err := validResponse.VisitPostOwnershipAddResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostOwnershipDelete operation middleware
+func (sh *strictHandler) PostOwnershipDelete(ctx *gin.Context) {
+	var request PostOwnershipDeleteRequestObject
+
+	var body PostOwnershipDeleteJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostOwnershipDelete(ctx, request.(PostOwnershipDeleteRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostOwnershipDelete")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostOwnershipDeleteResponseObject); ok {
+		if err := validResponse.VisitPostOwnershipDeleteResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetOwnershipList operation middleware
+func (sh *strictHandler) GetOwnershipList(ctx *gin.Context) {
+	var request GetOwnershipListRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetOwnershipList(ctx, request.(GetOwnershipListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetOwnershipList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetOwnershipListResponseObject); ok {
+		if err := validResponse.VisitGetOwnershipListResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestAddReviewer operation middleware
+func (sh *strictHandler) PostPullRequestAddReviewer(ctx *gin.Context) {
+	var request PostPullRequestAddReviewerRequestObject
+
+	var body PostPullRequestAddReviewerJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestAddReviewer(ctx, request.(PostPullRequestAddReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestAddReviewer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestAddReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestAddReviewerResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestCandidates operation middleware
+func (sh *strictHandler) GetPullRequestCandidates(ctx *gin.Context, params GetPullRequestCandidatesParams) {
+	var request GetPullRequestCandidatesRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestCandidates(ctx, request.(GetPullRequestCandidatesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestCandidates")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPullRequestCandidatesResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestCandidatesResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestClose operation middleware
+func (sh *strictHandler) PostPullRequestClose(ctx *gin.Context) {
+	var request PostPullRequestCloseRequestObject
+
+	var body PostPullRequestCloseJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestClose(ctx, request.(PostPullRequestCloseRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestClose")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestCloseResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCloseResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestCreate operation middleware
+func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
+	var request PostPullRequestCreateRequestObject
+
+	var body PostPullRequestCreateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestCreate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestCreateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestDecline operation middleware
+func (sh *strictHandler) PostPullRequestDecline(ctx *gin.Context) {
+	var request PostPullRequestDeclineRequestObject
+
+	var body PostPullRequestDeclineJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestDecline(ctx, request.(PostPullRequestDeclineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestDecline")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestDeclineResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestDeclineResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetPullRequestGet operation middleware
+func (sh *strictHandler) GetPullRequestGet(ctx *gin.Context, params GetPullRequestGetParams) {
+	var request GetPullRequestGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetPullRequestGet(ctx, request.(GetPullRequestGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetPullRequestGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetPullRequestGetResponseObject); ok {
+		if err := validResponse.VisitGetPullRequestGetResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestMerge operation middleware
+func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
+	var request PostPullRequestMergeRequestObject
+
+	var body PostPullRequestMergeJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestMerge")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestMergeResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestPreview operation middleware
+func (sh *strictHandler) PostPullRequestPreview(ctx *gin.Context) {
+	var request PostPullRequestPreviewRequestObject
+
+	var body PostPullRequestPreviewJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestPreview(ctx, request.(PostPullRequestPreviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestPreview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestPreviewResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestPreviewResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReady operation middleware
+func (sh *strictHandler) PostPullRequestReady(ctx *gin.Context) {
+	var request PostPullRequestReadyRequestObject
+
+	var body PostPullRequestReadyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReady(ctx, request.(PostPullRequestReadyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReady")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestReadyResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReadyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReassign operation middleware
+func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
+	var request PostPullRequestReassignRequestObject
+
+	var body PostPullRequestReassignJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReassign")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReassignResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestRemoveReviewer operation middleware
+func (sh *strictHandler) PostPullRequestRemoveReviewer(ctx *gin.Context) {
+	var request PostPullRequestRemoveReviewerRequestObject
+
+	var body PostPullRequestRemoveReviewerJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestRemoveReviewer(ctx, request.(PostPullRequestRemoveReviewerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestRemoveReviewer")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestRemoveReviewerResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestRemoveReviewerResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestReopen operation middleware
+func (sh *strictHandler) PostPullRequestReopen(ctx *gin.Context) {
+	var request PostPullRequestReopenRequestObject
+
+	var body PostPullRequestReopenJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestReopen(ctx, request.(PostPullRequestReopenRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestReopen")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestReopenResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestReopenResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestRerequestReview operation middleware
+func (sh *strictHandler) PostPullRequestRerequestReview(ctx *gin.Context) {
+	var request PostPullRequestRerequestReviewRequestObject
+
+	var body PostPullRequestRerequestReviewJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestRerequestReview(ctx, request.(PostPullRequestRerequestReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestRerequestReview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestRerequestReviewResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestRerequestReviewResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestSetLabels operation middleware
+func (sh *strictHandler) PostPullRequestSetLabels(ctx *gin.Context, params PostPullRequestSetLabelsParams) {
+	var request PostPullRequestSetLabelsRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestSetLabelsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestSetLabels(ctx, request.(PostPullRequestSetLabelsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestSetLabels")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestSetLabelsResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestSetLabelsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestSubmitReview operation middleware
+func (sh *strictHandler) PostPullRequestSubmitReview(ctx *gin.Context) {
+	var request PostPullRequestSubmitReviewRequestObject
+
+	var body PostPullRequestSubmitReviewJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestSubmitReview(ctx, request.(PostPullRequestSubmitReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestSubmitReview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestSubmitReviewResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestSubmitReviewResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostPullRequestUpdate operation middleware
+func (sh *strictHandler) PostPullRequestUpdate(ctx *gin.Context, params PostPullRequestUpdateParams) {
+	var request PostPullRequestUpdateRequestObject
+
+	request.Params = params
+
+	var body PostPullRequestUpdateJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostPullRequestUpdate(ctx, request.(PostPullRequestUpdateRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostPullRequestUpdate")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostPullRequestUpdateResponseObject); ok {
+		if err := validResponse.VisitPostPullRequestUpdateResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostRepositoryAdd operation middleware
+func (sh *strictHandler) PostRepositoryAdd(ctx *gin.Context) {
+	var request PostRepositoryAddRequestObject
+
+	var body PostRepositoryAddJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostRepositoryAdd(ctx, request.(PostRepositoryAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostRepositoryAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostRepositoryAddResponseObject); ok {
+		if err := validResponse.VisitPostRepositoryAddResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRepositoryGet operation middleware
+func (sh *strictHandler) GetRepositoryGet(ctx *gin.Context, params GetRepositoryGetParams) {
+	var request GetRepositoryGetRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRepositoryGet(ctx, request.(GetRepositoryGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRepositoryGet")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetRepositoryGetResponseObject); ok {
+		if err := validResponse.VisitGetRepositoryGetResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetRepositoryList operation middleware
+func (sh *strictHandler) GetRepositoryList(ctx *gin.Context) {
+	var request GetRepositoryListRequestObject
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetRepositoryList(ctx, request.(GetRepositoryListRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetRepositoryList")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetRepositoryListResponseObject); ok {
+		if err := validResponse.VisitGetRepositoryListResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetStatsPairings operation middleware
+func (sh *strictHandler) GetStatsPairings(ctx *gin.Context, params GetStatsPairingsParams) {
+	var request GetStatsPairingsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatsPairings(ctx, request.(GetStatsPairingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatsPairings")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetStatsPairingsResponseObject); ok {
+		if err := validResponse.VisitGetStatsPairingsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostTeamAdd operation middleware
+func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
+	var request PostTeamAddRequestObject
+
+	var body PostTeamAddJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamAdd")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
+		if err := validResponse.VisitPostTeamAddResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetTeamGet operation middleware
+func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
+	var request GetTeamGetRequestObject
 
-	return json.NewEncoder(w).Encode(response)
-}
+	request.Params = params
 
-type GetTeamGet404JSONResponse ErrorResponse
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamGet")
+	}
 
-func (response GetTeamGet404JSONResponse) VisitGetTeamGetResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
+		if err := validResponse.VisitGetTeamGetResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type GetUsersGetReviewRequestObject struct {
-	Params GetUsersGetReviewParams
-}
+// PostTeamRebalance operation middleware
+func (sh *strictHandler) PostTeamRebalance(ctx *gin.Context) {
+	var request PostTeamRebalanceRequestObject
 
-type GetUsersGetReviewResponseObject interface {
-	VisitGetUsersGetReviewResponse(w http.ResponseWriter) error
-}
+	var body PostTeamRebalanceJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type GetUsersGetReview200JSONResponse struct {
-	PullRequests []PullRequestShort `json:"pull_requests"`
-	UserId       string             `json:"user_id"`
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamRebalance(ctx, request.(PostTeamRebalanceRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamRebalance")
+	}
 
-func (response GetUsersGetReview200JSONResponse) VisitGetUsersGetReviewResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTeamRebalanceResponseObject); ok {
+		if err := validResponse.VisitPostTeamRebalanceResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostUsersSetIsActiveRequestObject struct {
-	Body *PostUsersSetIsActiveJSONRequestBody
-}
+// PostTeamSetReviewerStrategy operation middleware
+func (sh *strictHandler) PostTeamSetReviewerStrategy(ctx *gin.Context) {
+	var request PostTeamSetReviewerStrategyRequestObject
 
-type PostUsersSetIsActiveResponseObject interface {
-	VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error
-}
+	var body PostTeamSetReviewerStrategyJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
-type PostUsersSetIsActive200JSONResponse struct {
-	User *User `json:"user,omitempty"`
-}
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.PostTeamSetReviewerStrategy(ctx, request.(PostTeamSetReviewerStrategyRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PostTeamSetReviewerStrategy")
+	}
 
-func (response PostUsersSetIsActive200JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	response, err := handler(ctx, request)
 
-	return json.NewEncoder(w).Encode(response)
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(PostTeamSetReviewerStrategyResponseObject); ok {
+		if err := validResponse.VisitPostTeamSetReviewerStrategyResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type PostUsersSetIsActive404JSONResponse ErrorResponse
-
-func (response PostUsersSetIsActive404JSONResponse) VisitPostUsersSetIsActiveResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// GetTeamSettings operation middleware
+func (sh *strictHandler) GetTeamSettings(ctx *gin.Context, params GetTeamSettingsParams) {
+	var request GetTeamSettingsRequestObject
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Создать PR и автоматически назначить до 2 ревьюверов из команды автора
-	// (POST /pullRequest/create)
-	PostPullRequestCreate(ctx context.Context, request PostPullRequestCreateRequestObject) (PostPullRequestCreateResponseObject, error)
-	// Пометить PR как MERGED (идемпотентная операция)
-	// (POST /pullRequest/merge)
-	PostPullRequestMerge(ctx context.Context, request PostPullRequestMergeRequestObject) (PostPullRequestMergeResponseObject, error)
-	// Переназначить конкретного ревьювера на другого из его команды
-	// (POST /pullRequest/reassign)
-	PostPullRequestReassign(ctx context.Context, request PostPullRequestReassignRequestObject) (PostPullRequestReassignResponseObject, error)
-	// Создать команду с участниками (создаёт/обновляет пользователей)
-	// (POST /team/add)
-	PostTeamAdd(ctx context.Context, request PostTeamAddRequestObject) (PostTeamAddResponseObject, error)
-	// Получить команду с участниками
-	// (GET /team/get)
-	GetTeamGet(ctx context.Context, request GetTeamGetRequestObject) (GetTeamGetResponseObject, error)
-	// Получить PR'ы, где пользователь назначен ревьювером
-	// (GET /users/getReview)
-	GetUsersGetReview(ctx context.Context, request GetUsersGetReviewRequestObject) (GetUsersGetReviewResponseObject, error)
-	// Установить флаг активности пользователя
-	// (POST /users/setIsActive)
-	PostUsersSetIsActive(ctx context.Context, request PostUsersSetIsActiveRequestObject) (PostUsersSetIsActiveResponseObject, error)
-}
+	request.Params = params
 
-type StrictHandlerFunc = strictgin.StrictGinHandlerFunc
-type StrictMiddlewareFunc = strictgin.StrictGinMiddlewareFunc
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetTeamSettings(ctx, request.(GetTeamSettingsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetTeamSettings")
+	}
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares}
-}
+	response, err := handler(ctx, request)
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetTeamSettingsResponseObject); ok {
+		if err := validResponse.VisitGetTeamSettingsResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// PostPullRequestCreate operation middleware
-func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
-	var request PostPullRequestCreateRequestObject
+// PostTeamSettings operation middleware
+func (sh *strictHandler) PostTeamSettings(ctx *gin.Context) {
+	var request PostTeamSettingsRequestObject
 
-	var body PostPullRequestCreateJSONRequestBody
+	var body PostTeamSettingsJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -610,10 +3953,10 @@ func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestCreate(ctx, request.(PostPullRequestCreateRequestObject))
+		return sh.ssi.PostTeamSettings(ctx, request.(PostTeamSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestCreate")
+		handler = middleware(handler, "PostTeamSettings")
 	}
 
 	response, err := handler(ctx, request)
@@ -621,8 +3964,8 @@ func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPullRequestCreateResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestCreateResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostTeamSettingsResponseObject); ok {
+		if err := validResponse.VisitPostTeamSettingsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -630,11 +3973,38 @@ func (sh *strictHandler) PostPullRequestCreate(ctx *gin.Context) {
 	}
 }
 
-// PostPullRequestMerge operation middleware
-func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
-	var request PostPullRequestMergeRequestObject
+// GetUsersGetReview operation middleware
+func (sh *strictHandler) GetUsersGetReview(ctx *gin.Context, params GetUsersGetReviewParams) {
+	var request GetUsersGetReviewRequestObject
 
-	var body PostPullRequestMergeJSONRequestBody
+	request.Params = params
+
+	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
+		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetUsersGetReview")
+	}
+
+	response, err := handler(ctx, request)
+
+	if err != nil {
+		ctx.Error(err)
+		ctx.Status(http.StatusInternalServerError)
+	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
+		if err := validResponse.VisitGetUsersGetReviewResponse(ctx.Writer); err != nil {
+			ctx.Error(err)
+		}
+	} else if response != nil {
+		ctx.Error(fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PostUsersSetIsActive operation middleware
+func (sh *strictHandler) PostUsersSetIsActive(ctx *gin.Context) {
+	var request PostUsersSetIsActiveRequestObject
+
+	var body PostUsersSetIsActiveJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -643,10 +4013,10 @@ func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestMerge(ctx, request.(PostPullRequestMergeRequestObject))
+		return sh.ssi.PostUsersSetIsActive(ctx, request.(PostUsersSetIsActiveRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestMerge")
+		handler = middleware(handler, "PostUsersSetIsActive")
 	}
 
 	response, err := handler(ctx, request)
@@ -654,8 +4024,8 @@ func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPullRequestMergeResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestMergeResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetIsActiveResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetIsActiveResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -663,11 +4033,11 @@ func (sh *strictHandler) PostPullRequestMerge(ctx *gin.Context) {
 	}
 }
 
-// PostPullRequestReassign operation middleware
-func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
-	var request PostPullRequestReassignRequestObject
+// PostUsersSetReviewCapacity operation middleware
+func (sh *strictHandler) PostUsersSetReviewCapacity(ctx *gin.Context) {
+	var request PostUsersSetReviewCapacityRequestObject
 
-	var body PostPullRequestReassignJSONRequestBody
+	var body PostUsersSetReviewCapacityJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -676,10 +4046,10 @@ func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostPullRequestReassign(ctx, request.(PostPullRequestReassignRequestObject))
+		return sh.ssi.PostUsersSetReviewCapacity(ctx, request.(PostUsersSetReviewCapacityRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostPullRequestReassign")
+		handler = middleware(handler, "PostUsersSetReviewCapacity")
 	}
 
 	response, err := handler(ctx, request)
@@ -687,8 +4057,8 @@ func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostPullRequestReassignResponseObject); ok {
-		if err := validResponse.VisitPostPullRequestReassignResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetReviewCapacityResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetReviewCapacityResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -696,11 +4066,11 @@ func (sh *strictHandler) PostPullRequestReassign(ctx *gin.Context) {
 	}
 }
 
-// PostTeamAdd operation middleware
-func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
-	var request PostTeamAddRequestObject
+// PostUsersSetReviewWeight operation middleware
+func (sh *strictHandler) PostUsersSetReviewWeight(ctx *gin.Context) {
+	var request PostUsersSetReviewWeightRequestObject
 
-	var body PostTeamAddJSONRequestBody
+	var body PostUsersSetReviewWeightJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -709,10 +4079,10 @@ func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostTeamAdd(ctx, request.(PostTeamAddRequestObject))
+		return sh.ssi.PostUsersSetReviewWeight(ctx, request.(PostUsersSetReviewWeightRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostTeamAdd")
+		handler = middleware(handler, "PostUsersSetReviewWeight")
 	}
 
 	response, err := handler(ctx, request)
@@ -720,8 +4090,8 @@ func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostTeamAddResponseObject); ok {
-		if err := validResponse.VisitPostTeamAddResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetReviewWeightResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetReviewWeightResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -729,17 +4099,23 @@ func (sh *strictHandler) PostTeamAdd(ctx *gin.Context) {
 	}
 }
 
-// GetTeamGet operation middleware
-func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
-	var request GetTeamGetRequestObject
+// PostUsersSetRole operation middleware
+func (sh *strictHandler) PostUsersSetRole(ctx *gin.Context) {
+	var request PostUsersSetRoleRequestObject
 
-	request.Params = params
+	var body PostUsersSetRoleJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetTeamGet(ctx, request.(GetTeamGetRequestObject))
+		return sh.ssi.PostUsersSetRole(ctx, request.(PostUsersSetRoleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetTeamGet")
+		handler = middleware(handler, "PostUsersSetRole")
 	}
 
 	response, err := handler(ctx, request)
@@ -747,8 +4123,8 @@ func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetTeamGetResponseObject); ok {
-		if err := validResponse.VisitGetTeamGetResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetRoleResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetRoleResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -756,17 +4132,23 @@ func (sh *strictHandler) GetTeamGet(ctx *gin.Context, params GetTeamGetParams) {
 	}
 }
 
-// GetUsersGetReview operation middleware
-func (sh *strictHandler) GetUsersGetReview(ctx *gin.Context, params GetUsersGetReviewParams) {
-	var request GetUsersGetReviewRequestObject
+// PostUsersSetSkills operation middleware
+func (sh *strictHandler) PostUsersSetSkills(ctx *gin.Context) {
+	var request PostUsersSetSkillsRequestObject
 
-	request.Params = params
+	var body PostUsersSetSkillsJSONRequestBody
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.Status(http.StatusBadRequest)
+		ctx.Error(err)
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.GetUsersGetReview(ctx, request.(GetUsersGetReviewRequestObject))
+		return sh.ssi.PostUsersSetSkills(ctx, request.(PostUsersSetSkillsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetUsersGetReview")
+		handler = middleware(handler, "PostUsersSetSkills")
 	}
 
 	response, err := handler(ctx, request)
@@ -774,8 +4156,8 @@ func (sh *strictHandler) GetUsersGetReview(ctx *gin.Context, params GetUsersGetR
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(GetUsersGetReviewResponseObject); ok {
-		if err := validResponse.VisitGetUsersGetReviewResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetSkillsResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetSkillsResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -783,11 +4165,11 @@ func (sh *strictHandler) GetUsersGetReview(ctx *gin.Context, params GetUsersGetR
 	}
 }
 
-// PostUsersSetIsActive operation middleware
-func (sh *strictHandler) PostUsersSetIsActive(ctx *gin.Context) {
-	var request PostUsersSetIsActiveRequestObject
+// PostUsersSetWorkingHours operation middleware
+func (sh *strictHandler) PostUsersSetWorkingHours(ctx *gin.Context) {
+	var request PostUsersSetWorkingHoursRequestObject
 
-	var body PostUsersSetIsActiveJSONRequestBody
+	var body PostUsersSetWorkingHoursJSONRequestBody
 	if err := ctx.ShouldBindJSON(&body); err != nil {
 		ctx.Status(http.StatusBadRequest)
 		ctx.Error(err)
@@ -796,10 +4178,10 @@ func (sh *strictHandler) PostUsersSetIsActive(ctx *gin.Context) {
 	request.Body = &body
 
 	handler := func(ctx *gin.Context, request interface{}) (interface{}, error) {
-		return sh.ssi.PostUsersSetIsActive(ctx, request.(PostUsersSetIsActiveRequestObject))
+		return sh.ssi.PostUsersSetWorkingHours(ctx, request.(PostUsersSetWorkingHoursRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "PostUsersSetIsActive")
+		handler = middleware(handler, "PostUsersSetWorkingHours")
 	}
 
 	response, err := handler(ctx, request)
@@ -807,8 +4189,8 @@ func (sh *strictHandler) PostUsersSetIsActive(ctx *gin.Context) {
 	if err != nil {
 		ctx.Error(err)
 		ctx.Status(http.StatusInternalServerError)
-	} else if validResponse, ok := response.(PostUsersSetIsActiveResponseObject); ok {
-		if err := validResponse.VisitPostUsersSetIsActiveResponse(ctx.Writer); err != nil {
+	} else if validResponse, ok := response.(PostUsersSetWorkingHoursResponseObject); ok {
+		if err := validResponse.VisitPostUsersSetWorkingHoursResponse(ctx.Writer); err != nil {
 			ctx.Error(err)
 		}
 	} else if response != nil {
@@ -0,0 +1,53 @@
+package handlers
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+	openapi "github.com/tdenkov123/avitotech_internship_2025/internal/http_server/api"
+)
+
+// withETag sets the ETag header to the pull request version and converts the
+// pull request for the response body.
+func withETag(c *gin.Context, pr domain.PullRequest) openapi.PullRequest {
+	c.Header("ETag", fmt.Sprintf("%q", strconv.Itoa(pr.Version)))
+	return toAPIPullRequest(pr)
+}
+
+// parseIfMatch returns the version from an If-Match header. A nil version
+// means "*", which matches any version. ok is false when the header is missing.
+func parseIfMatch(header string) (version *int, ok bool, err error) {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return nil, false, nil
+	}
+	if header == "*" {
+		return nil, true, nil
+	}
+	tag := strings.Trim(strings.TrimPrefix(header, "W/"), `"`)
+	v, err := strconv.Atoi(tag)
+	if err != nil {
+		return nil, true, fmt.Errorf("%w: unrecognized If-Match %s", domain.ErrVersionMismatch, header)
+	}
+	return &v, true, nil
+}
+
+// requireIfMatch parses the If-Match header of a conditional write. When the
+// header is missing or invalid it writes the error response and returns false.
+func (h *APIHandler) requireIfMatch(c *gin.Context, header *string) (*int, bool) {
+	version, ok, err := parseIfMatch(derefString(header))
+	if !ok {
+		c.JSON(http.StatusPreconditionRequired, newErrorResponse(openapi.PRECONDITIONREQUIRED, "If-Match header is required"))
+		return nil, false
+	}
+	if err != nil {
+		h.handleError(c, err)
+		return nil, false
+	}
+	return version, true
+}
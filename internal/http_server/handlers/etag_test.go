@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+func TestParseIfMatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		header      string
+		wantVersion *int
+		wantOK      bool
+		wantErr     error
+	}{
+		{name: "missing", header: "", wantOK: false},
+		{name: "blank", header: "  ", wantOK: false},
+		{name: "wildcard", header: "*", wantOK: true},
+		{name: "quoted", header: `"3"`, wantVersion: intPtr(3), wantOK: true},
+		{name: "unquoted", header: "4", wantVersion: intPtr(4), wantOK: true},
+		{name: "weak", header: `W/"5"`, wantVersion: intPtr(5), wantOK: true},
+		{name: "garbage", header: `"abc"`, wantOK: true, wantErr: domain.ErrVersionMismatch},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, ok, err := parseIfMatch(tt.header)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			switch {
+			case version == nil && tt.wantVersion == nil:
+			case version == nil || tt.wantVersion == nil || *version != *tt.wantVersion:
+				t.Errorf("version = %v, want %v", version, tt.wantVersion)
+			}
+		})
+	}
+}
+
+func intPtr(v int) *int {
+	return &v
+}
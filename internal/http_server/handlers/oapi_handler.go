@@ -29,9 +29,10 @@ type deactivateRequest struct {
 }
 
 type apiReassignment struct {
-	PullRequestID string  `json:"pull_request_id"`
-	OldReviewerID string  `json:"old_reviewer_id"`
-	NewReviewerID *string `json:"new_reviewer_id"`
+	PullRequestID string                          `json:"pull_request_id"`
+	OldReviewerID string                          `json:"old_reviewer_id"`
+	NewReviewerID *string                         `json:"new_reviewer_id"`
+	Reason        *openapi.ErrorResponseErrorCode `json:"reason,omitempty"`
 }
 
 func NewAPIHandler(logger *zap.Logger, svc *service.Service) *APIHandler {
@@ -49,17 +50,38 @@ func (h *APIHandler) handleError(c *gin.Context, err error) {
 	switch {
 	case errors.Is(err, domain.ErrTeamExists):
 		c.JSON(http.StatusBadRequest, newErrorResponse(openapi.TEAMEXISTS, err.Error()))
-	case errors.Is(err, domain.ErrTeamNotFound), errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrPullRequestNotFound):
+	case errors.Is(err, domain.ErrTeamNotFound), errors.Is(err, domain.ErrUserNotFound), errors.Is(err, domain.ErrPullRequestNotFound),
+		errors.Is(err, domain.ErrOwnershipRuleNotFound), errors.Is(err, domain.ErrRepositoryNotFound):
 		c.JSON(http.StatusNotFound, newErrorResponse(openapi.NOTFOUND, err.Error()))
-	case errors.Is(err, domain.ErrPullRequestExists), errors.Is(err, domain.ErrUserHasOpenPR):
+	case errors.Is(err, domain.ErrRepositoryExists):
+		c.JSON(http.StatusConflict, newErrorResponse(openapi.REPOSITORYEXISTS, err.Error()))
+	case errors.Is(err, domain.ErrPullRequestExists):
 		c.JSON(http.StatusConflict, newErrorResponse(openapi.PREXISTS, err.Error()))
+	case errors.Is(err, domain.ErrUserHasOpenPR):
+		c.JSON(http.StatusConflict, newErrorResponse(openapi.OPENPRLIMIT, err.Error()))
 	case errors.Is(err, domain.ErrPullRequestMerged):
 		c.JSON(http.StatusConflict, newErrorResponse(openapi.PRMERGED, err.Error()))
 	case errors.Is(err, domain.ErrReviewerNotAssigned):
 		c.JSON(http.StatusConflict, newErrorResponse(openapi.NOTASSIGNED, err.Error()))
-	case errors.Is(err, domain.ErrNoCandidate):
+	case errors.Is(err, domain.ErrNoCandidate), errors.Is(err, domain.ErrNoSeniorCandidate):
 		c.JSON(http.StatusConflict, newErrorResponse(openapi.NOCANDIDATE, err.Error()))
-	case errors.Is(err, domain.ErrInvalidInput):
+	case errors.Is(err, domain.ErrNotEnoughReviewers):
+		c.JSON(http.StatusConflict, newErrorResponse(openapi.NOTENOUGHREVIEWERS, err.Error()))
+	case errors.Is(err, domain.ErrReviewersAtCapacity):
+		c.JSON(http.StatusConflict, newErrorResponse(openapi.ATCAPACITY, err.Error()))
+	case errors.Is(err, domain.ErrReviewerPinned):
+		c.JSON(http.StatusConflict, newErrorResponse(openapi.REVIEWERPINNED, err.Error()))
+	case errors.Is(err, domain.ErrNotApproved):
+		c.JSON(http.StatusConflict, newErrorResponse(openapi.NOTAPPROVED, err.Error()))
+	case errors.Is(err, domain.ErrPullRequestClosed):
+		c.JSON(http.StatusConflict, newErrorResponse(openapi.PRCLOSED, err.Error()))
+	case errors.Is(err, domain.ErrPullRequestDraft):
+		c.JSON(http.StatusConflict, newErrorResponse(openapi.PRDRAFT, err.Error()))
+	case errors.Is(err, domain.ErrInvalidTransition):
+		c.JSON(http.StatusConflict, newErrorResponse(openapi.INVALIDTRANSITION, err.Error()))
+	case errors.Is(err, domain.ErrVersionMismatch):
+		c.JSON(http.StatusPreconditionFailed, newErrorResponse(openapi.VERSIONMISMATCH, err.Error()))
+	case errors.Is(err, domain.ErrInvalidInput), errors.Is(err, domain.ErrUnknownStrategy):
 		c.JSON(http.StatusBadRequest, newErrorResponse(openapi.NOTFOUND, err.Error()))
 	default:
 		h.logger.Error("unexpected error", zap.Error(err))
@@ -81,17 +103,35 @@ func (h *APIHandler) PostPullRequestCreate(c *gin.Context) {
 		return
 	}
 
-	pr, err := h.service.CreatePullRequest(c.Request.Context(), service.CreatePullRequestInput{
-		ID:       req.PullRequestId,
-		Name:     req.PullRequestName,
-		AuthorID: req.AuthorId,
+	result, err := h.service.CreatePullRequest(c.Request.Context(), toCreatePullRequestInput(req))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{
+		"pr":       withETag(c, result.PullRequest),
+		"warnings": nonNilStrings(result.Warnings),
 	})
+}
+
+func (h *APIHandler) PostPullRequestPreview(c *gin.Context) {
+	var req openapi.PostPullRequestPreviewJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	result, err := h.service.PreviewPullRequest(c.Request.Context(), toCreatePullRequestInput(req))
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusCreated, gin.H{"pr": toAPIPullRequest(pr)})
+	c.JSON(http.StatusOK, gin.H{
+		"pr":       toAPIPullRequest(result.PullRequest),
+		"warnings": nonNilStrings(result.Warnings),
+	})
 }
 
 func (h *APIHandler) PostPullRequestMerge(c *gin.Context) {
@@ -101,13 +141,154 @@ func (h *APIHandler) PostPullRequestMerge(c *gin.Context) {
 		return
 	}
 
-	pr, err := h.service.MergePullRequest(c.Request.Context(), req.PullRequestId)
+	input := service.MergeInput{
+		PullRequestID:  req.PullRequestId,
+		OverrideReason: derefString(req.OverrideReason),
+	}
+	if req.Override != nil {
+		input.Override = *req.Override
+	}
+
+	pr, err := h.service.MergePullRequest(c.Request.Context(), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": withETag(c, pr)})
+}
+
+func (h *APIHandler) PostPullRequestReady(c *gin.Context) {
+	var req openapi.PostPullRequestReadyJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	result, err := h.service.MarkPullRequestReady(c.Request.Context(), req.PullRequestId)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"pr":       withETag(c, result.PullRequest),
+		"warnings": nonNilStrings(result.Warnings),
+	})
+}
+
+func (h *APIHandler) PostPullRequestClose(c *gin.Context) {
+	var req openapi.PostPullRequestCloseJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	pr, err := h.service.ClosePullRequest(c.Request.Context(), req.PullRequestId)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": withETag(c, pr)})
+}
+
+func (h *APIHandler) PostPullRequestReopen(c *gin.Context) {
+	var req openapi.PostPullRequestReopenJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	result, err := h.service.ReopenPullRequest(c.Request.Context(), req.PullRequestId)
 	if err != nil {
 		h.handleError(c, err)
 		return
 	}
 
-	c.JSON(http.StatusOK, gin.H{"pr": toAPIPullRequest(pr)})
+	c.JSON(http.StatusOK, gin.H{
+		"pr":       withETag(c, result.PullRequest),
+		"warnings": nonNilStrings(result.Warnings),
+	})
+}
+
+func (h *APIHandler) GetPullRequestGet(c *gin.Context, params openapi.GetPullRequestGetParams) {
+	var (
+		pr  domain.PullRequest
+		err error
+	)
+	switch {
+	case params.PullRequestId != nil:
+		pr, err = h.service.FetchPullRequest(c.Request.Context(), *params.PullRequestId)
+	case params.Number != nil:
+		pr, err = h.service.FetchPullRequestByNumber(c.Request.Context(), derefString(params.Repository), *params.Number)
+	default:
+		err = domain.ErrInvalidInput
+	}
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": withETag(c, pr)})
+}
+
+func (h *APIHandler) PostPullRequestUpdate(c *gin.Context, params openapi.PostPullRequestUpdateParams) {
+	var req openapi.PostPullRequestUpdateJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	version, ok := h.requireIfMatch(c, params.IfMatch)
+	if !ok {
+		return
+	}
+
+	input := service.UpdatePullRequestInput{
+		PullRequestID: req.PullRequestId,
+		Version:       version,
+		Name:          req.PullRequestName,
+		Description:   req.Description,
+		Repository:    req.Repository,
+		Size:          fromAPIDiffSize(req.Size),
+	}
+	if req.Labels != nil {
+		input.Labels = *req.Labels
+	}
+
+	pr, err := h.service.UpdatePullRequest(c.Request.Context(), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": withETag(c, pr)})
+}
+
+func (h *APIHandler) PostPullRequestSetLabels(c *gin.Context, params openapi.PostPullRequestSetLabelsParams) {
+	var req openapi.PostPullRequestSetLabelsJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	version, ok := h.requireIfMatch(c, params.IfMatch)
+	if !ok {
+		return
+	}
+
+	pr, err := h.service.UpdatePullRequest(c.Request.Context(), service.UpdatePullRequestInput{
+		PullRequestID: req.PullRequestId,
+		Version:       version,
+		Labels:        nonNilStrings(req.Labels),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": withETag(c, pr)})
 }
 
 func (h *APIHandler) PostPullRequestReassign(c *gin.Context) {
@@ -137,8 +318,136 @@ func (h *APIHandler) PostPullRequestReassign(c *gin.Context) {
 	}
 
 	c.JSON(http.StatusOK, gin.H{
-		"pr":          toAPIPullRequest(result.PullRequest),
+		"pr":          withETag(c, result.PullRequest),
+		"replaced_by": result.ReplacedBy,
+	})
+}
+
+func (h *APIHandler) PostPullRequestSubmitReview(c *gin.Context) {
+	var req openapi.PostPullRequestSubmitReviewJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	pr, err := h.service.SubmitReview(c.Request.Context(), service.SubmitReviewInput{
+		PullRequestID: req.PullRequestId,
+		ReviewerID:    req.UserId,
+		State:         string(req.State),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": withETag(c, pr)})
+}
+
+func (h *APIHandler) PostPullRequestRerequestReview(c *gin.Context) {
+	var req openapi.PostPullRequestRerequestReviewJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	pr, err := h.service.RerequestReview(c.Request.Context(), req.PullRequestId)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": withETag(c, pr)})
+}
+
+func (h *APIHandler) PostPullRequestDecline(c *gin.Context) {
+	var req openapi.PostPullRequestDeclineJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	result, err := h.service.DeclineReview(c.Request.Context(), service.DeclineInput{
+		PullRequestID: req.PullRequestId,
+		ReviewerID:    req.UserId,
+		Reason:        string(req.Reason),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	response := gin.H{
+		"pr":          withETag(c, result.PullRequest),
 		"replaced_by": result.ReplacedBy,
+	}
+	if code := replacementFailureCode(result.Failure); code != nil {
+		response["reason"] = code
+	}
+	c.JSON(http.StatusOK, response)
+}
+
+func (h *APIHandler) PostPullRequestAddReviewer(c *gin.Context) {
+	var req openapi.PostPullRequestAddReviewerJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	pr, err := h.service.AddReviewer(c.Request.Context(), service.AddReviewerInput{
+		PullRequestID: req.PullRequestId,
+		UserID:        req.UserId,
+		Pinned:        req.Pinned,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": withETag(c, pr)})
+}
+
+func (h *APIHandler) PostPullRequestRemoveReviewer(c *gin.Context) {
+	var req openapi.PostPullRequestRemoveReviewerJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	pr, err := h.service.RemoveReviewer(c.Request.Context(), req.PullRequestId, req.UserId)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"pr": withETag(c, pr)})
+}
+
+func (h *APIHandler) GetPullRequestCandidates(c *gin.Context, params openapi.GetPullRequestCandidatesParams) {
+	candidates, err := h.service.RankReplacementCandidates(c.Request.Context(), params.PullRequestId, derefString(params.OldUserId))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	result := make([]openapi.ReviewerCandidate, 0, len(candidates))
+	for _, candidate := range candidates {
+		result = append(result, openapi.ReviewerCandidate{
+			UserId:         candidate.UserID,
+			TeamName:       candidate.TeamName,
+			Role:           openapi.UserRole(candidate.Role),
+			Score:          candidate.Score,
+			OpenReviews:    candidate.OpenReviews,
+			MaxOpenReviews: candidate.MaxOpenReviews,
+			MatchedSkills:  nonNilStrings(candidate.MatchedSkills),
+			RecentPairings: candidate.RecentPairings,
+			IsFallback:     candidate.Fallback,
+			IsAvailable:    candidate.Available,
+			Reasons:        nonNilStrings(candidate.Reasons),
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"pull_request_id": params.PullRequestId,
+		"candidates":      result,
 	})
 }
 
@@ -153,12 +462,24 @@ func (h *APIHandler) PostTeamAdd(c *gin.Context) {
 		Name:    req.TeamName,
 		Members: make([]domain.TeamMember, 0, len(req.Members)),
 	}
+	if req.ReviewerStrategy != nil {
+		team.ReviewerStrategy = *req.ReviewerStrategy
+	}
 	for _, member := range req.Members {
-		team.Members = append(team.Members, domain.TeamMember{
-			UserID:   member.UserId,
-			Username: member.Username,
-			IsActive: member.IsActive,
-		})
+		teamMember := domain.TeamMember{
+			UserID:         member.UserId,
+			Username:       member.Username,
+			IsActive:       member.IsActive,
+			MaxOpenReviews: member.MaxOpenReviews,
+			ReviewWeight:   member.ReviewWeight,
+		}
+		if member.Role != nil {
+			teamMember.Role = string(*member.Role)
+		}
+		if member.Skills != nil {
+			teamMember.Skills = *member.Skills
+		}
+		team.Members = append(team.Members, teamMember)
 	}
 
 	created, err := h.service.CreateTeam(c.Request.Context(), team)
@@ -180,8 +501,69 @@ func (h *APIHandler) GetTeamGet(c *gin.Context, params openapi.GetTeamGetParams)
 	c.JSON(http.StatusOK, toAPITeam(team))
 }
 
+func (h *APIHandler) PostTeamSetReviewerStrategy(c *gin.Context) {
+	var req openapi.PostTeamSetReviewerStrategyJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	team, err := h.service.SetTeamReviewerStrategy(c.Request.Context(), req.TeamName, req.ReviewerStrategy)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"team": toAPITeam(team)})
+}
+
+func (h *APIHandler) GetTeamSettings(c *gin.Context, params openapi.GetTeamSettingsParams) {
+	settings, err := h.service.GetTeamSettings(c.Request.Context(), params.TeamName)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, toAPITeamSettings(settings))
+}
+
+func (h *APIHandler) PostTeamSettings(c *gin.Context) {
+	var req openapi.PostTeamSettingsJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	input := service.UpdateTeamSettingsInput{
+		TeamName:           req.TeamName,
+		ReviewerCount:      req.ReviewerCount,
+		MinReviewers:       req.MinReviewers,
+		MinSeniorReviewers: req.MinSeniorReviewers,
+		RequiredApprovals:  req.RequiredApprovals,
+		MaxOpenPRs:         req.MaxOpenPullRequests,
+	}
+	if req.FallbackTeams != nil {
+		input.FallbackTeams = *req.FallbackTeams
+	}
+	input.PairingWindowPRs = req.PairingWindowPrs
+	input.PairingWindowDays = req.PairingWindowDays
+	if req.MinReviewersPolicy != nil {
+		policy := string(*req.MinReviewersPolicy)
+		input.MinReviewersPolicy = &policy
+	}
+
+	settings, err := h.service.UpdateTeamSettings(c.Request.Context(), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"settings": toAPITeamSettings(settings)})
+}
+
 func (h *APIHandler) GetUsersGetReview(c *gin.Context, params openapi.GetUsersGetReviewParams) {
-	prs, err := h.service.GetUserReviews(c.Request.Context(), params.UserId)
+	pending := params.Pending != nil && *params.Pending
+	prs, err := h.service.GetUserReviews(c.Request.Context(), params.UserId, pending)
 	if err != nil {
 		h.handleError(c, err)
 		return
@@ -209,6 +591,91 @@ func (h *APIHandler) PostUsersSetIsActive(c *gin.Context) {
 	c.JSON(http.StatusOK, gin.H{"user": toAPIUser(user)})
 }
 
+func (h *APIHandler) PostUsersSetReviewCapacity(c *gin.Context) {
+	var req openapi.PostUsersSetReviewCapacityJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	user, err := h.service.SetUserReviewCapacity(c.Request.Context(), req.UserId, req.MaxOpenReviews)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user": toAPIUser(user)})
+}
+
+func (h *APIHandler) PostUsersSetRole(c *gin.Context) {
+	var req openapi.PostUsersSetRoleJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	user, err := h.service.SetUserRole(c.Request.Context(), req.UserId, string(req.Role))
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user": toAPIUser(user)})
+}
+
+func (h *APIHandler) PostUsersSetWorkingHours(c *gin.Context) {
+	var req openapi.PostUsersSetWorkingHoursJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	user, err := h.service.SetUserWorkingHours(c.Request.Context(), service.SetWorkingHoursInput{
+		UserID:    req.UserId,
+		Timezone:  derefString(req.Timezone),
+		WorkStart: derefString(req.WorkStart),
+		WorkEnd:   derefString(req.WorkEnd),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user": toAPIUser(user)})
+}
+
+func (h *APIHandler) PostUsersSetReviewWeight(c *gin.Context) {
+	var req openapi.PostUsersSetReviewWeightJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	user, err := h.service.SetUserReviewWeight(c.Request.Context(), req.UserId, req.ReviewWeight)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user": toAPIUser(user)})
+}
+
+func (h *APIHandler) PostUsersSetSkills(c *gin.Context) {
+	var req openapi.PostUsersSetSkillsJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	user, err := h.service.SetUserSkills(c.Request.Context(), req.UserId, req.Skills)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"user": toAPIUser(user)})
+}
+
 func (h *APIHandler) DeactivateTeamMembers(c *gin.Context) {
 	var req deactivateRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
@@ -233,27 +700,276 @@ func (h *APIHandler) DeactivateTeamMembers(c *gin.Context) {
 	})
 }
 
+func (h *APIHandler) PostTeamRebalance(c *gin.Context) {
+	var req openapi.PostTeamRebalanceJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	input := service.RebalanceInput{
+		TeamName:  req.TeamName,
+		Threshold: req.Threshold,
+	}
+	if req.DryRun != nil {
+		input.DryRun = *req.DryRun
+	}
+
+	result, err := h.service.RebalanceTeam(c.Request.Context(), input)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{
+		"team_name":     result.TeamName,
+		"average":       result.Average,
+		"threshold":     result.Threshold,
+		"dry_run":       result.DryRun,
+		"reassignments": toAPIReassignments(result.Reassignments),
+	})
+}
+
+func (h *APIHandler) PostRepositoryAdd(c *gin.Context) {
+	var req openapi.PostRepositoryAddJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	repo, err := h.service.AddRepository(c.Request.Context(), domain.Repository{
+		Name:              req.RepositoryName,
+		DefaultTeam:       derefString(req.DefaultTeam),
+		ReviewerCount:     req.ReviewerCount,
+		MinReviewers:      req.MinReviewers,
+		RequiredApprovals: req.RequiredApprovals,
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"repository": toAPIRepository(repo)})
+}
+
+func (h *APIHandler) GetRepositoryGet(c *gin.Context, params openapi.GetRepositoryGetParams) {
+	repo, err := h.service.GetRepository(c.Request.Context(), params.RepositoryName)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"repository": toAPIRepository(repo)})
+}
+
+func (h *APIHandler) GetRepositoryList(c *gin.Context) {
+	repos, err := h.service.ListRepositories(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	result := make([]openapi.Repository, 0, len(repos))
+	for _, repo := range repos {
+		result = append(result, toAPIRepository(repo))
+	}
+	c.JSON(http.StatusOK, gin.H{"repositories": result})
+}
+
+func (h *APIHandler) PostOwnershipAdd(c *gin.Context) {
+	var req openapi.PostOwnershipAddJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	rule, err := h.service.AddOwnershipRule(c.Request.Context(), domain.OwnershipRule{
+		Pattern:  req.Pattern,
+		UserID:   derefString(req.UserId),
+		TeamName: derefString(req.TeamName),
+	})
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusCreated, gin.H{"rule": toAPIOwnershipRule(rule)})
+}
+
+func (h *APIHandler) GetOwnershipList(c *gin.Context) {
+	rules, err := h.service.ListOwnershipRules(c.Request.Context())
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	result := make([]openapi.OwnershipRule, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, toAPIOwnershipRule(rule))
+	}
+	c.JSON(http.StatusOK, gin.H{"rules": result})
+}
+
+func (h *APIHandler) PostOwnershipDelete(c *gin.Context) {
+	var req openapi.PostOwnershipDeleteJSONRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		h.respondValidationError(c, err)
+		return
+	}
+
+	if err := h.service.DeleteOwnershipRule(c.Request.Context(), req.RuleId); err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"rule_id": req.RuleId})
+}
+
+func (h *APIHandler) GetStatsPairings(c *gin.Context, params openapi.GetStatsPairingsParams) {
+	days := 0
+	if params.Days != nil {
+		days = *params.Days
+	}
+
+	stats, err := h.service.GetPairingStats(c.Request.Context(), params.TeamName, days)
+	if err != nil {
+		h.handleError(c, err)
+		return
+	}
+
+	pairings := make([]openapi.Pairing, 0, len(stats.Pairings))
+	for _, pairing := range stats.Pairings {
+		pairings = append(pairings, openapi.Pairing{
+			AuthorId:   pairing.AuthorID,
+			ReviewerId: pairing.ReviewerID,
+			Count:      pairing.Count,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"team_name": stats.TeamName,
+		"days":      stats.Days,
+		"pairings":  pairings,
+	})
+}
+
+func toCreatePullRequestInput(req openapi.CreatePullRequestRequest) service.CreatePullRequestInput {
+	input := service.CreatePullRequestInput{
+		ID:       derefString(req.PullRequestId),
+		Name:     req.PullRequestName,
+		AuthorID: req.AuthorId,
+	}
+	if req.ChangedFiles != nil {
+		input.ChangedFiles = *req.ChangedFiles
+	}
+	if req.Labels != nil {
+		input.Labels = *req.Labels
+	}
+	if req.Draft != nil {
+		input.Draft = *req.Draft
+	}
+	input.Description = derefString(req.Description)
+	input.Repository = derefString(req.Repository)
+	input.Number = req.Number
+	input.Size = fromAPIDiffSize(req.Size)
+	return input
+}
+
+func fromAPIDiffSize(size *openapi.DiffSize) *domain.DiffSize {
+	if size == nil {
+		return nil
+	}
+	return &domain.DiffSize{
+		LinesAdded:   size.LinesAdded,
+		LinesRemoved: size.LinesRemoved,
+		FilesChanged: size.FilesChanged,
+	}
+}
+
+func toAPIDiffSize(size *domain.DiffSize) *openapi.DiffSize {
+	if size == nil {
+		return nil
+	}
+	return &openapi.DiffSize{
+		LinesAdded:   size.LinesAdded,
+		LinesRemoved: size.LinesRemoved,
+		FilesChanged: size.FilesChanged,
+	}
+}
+
 func toAPITeam(team domain.Team) openapi.Team {
 	members := make([]openapi.TeamMember, 0, len(team.Members))
 	for _, member := range team.Members {
+		skills := nonNilStrings(member.Skills)
+		role := openapi.UserRole(member.Role)
 		members = append(members, openapi.TeamMember{
-			UserId:   member.UserID,
-			Username: member.Username,
-			IsActive: member.IsActive,
+			UserId:         member.UserID,
+			Username:       member.Username,
+			IsActive:       member.IsActive,
+			Role:           &role,
+			Skills:         &skills,
+			MaxOpenReviews: member.MaxOpenReviews,
+			ReviewWeight:   member.ReviewWeight,
 		})
 	}
+	strategy := team.ReviewerStrategy
 	return openapi.Team{
-		TeamName: team.Name,
-		Members:  members,
+		TeamName:         team.Name,
+		ReviewerStrategy: &strategy,
+		Members:          members,
+	}
+}
+
+func toAPITeamSettings(settings domain.TeamSettings) openapi.TeamSettings {
+	return openapi.TeamSettings{
+		TeamName:            settings.TeamName,
+		ReviewerCount:       settings.ReviewerCount,
+		MinReviewers:        settings.MinReviewers,
+		MinReviewersPolicy:  openapi.TeamSettingsMinReviewersPolicy(settings.MinReviewersPolicy),
+		MinSeniorReviewers:  settings.MinSeniorReviewers,
+		RequiredApprovals:   settings.RequiredApprovals,
+		MaxOpenPullRequests: settings.MaxOpenPRs,
+		FallbackTeams:       nonNilStrings(settings.FallbackTeams),
+		PairingWindowPrs:    settings.PairingWindowPRs,
+		PairingWindowDays:   settings.PairingWindowDays,
+	}
+}
+
+func toAPIOwnershipRule(rule domain.OwnershipRule) openapi.OwnershipRule {
+	return openapi.OwnershipRule{
+		RuleId:   rule.ID,
+		Pattern:  rule.Pattern,
+		UserId:   optionalString(rule.UserID),
+		TeamName: optionalString(rule.TeamName),
+	}
+}
+
+func toAPIRepository(repo domain.Repository) openapi.Repository {
+	created := repo.CreatedAt
+	return openapi.Repository{
+		RepositoryName:    repo.Name,
+		DefaultTeam:       optionalString(repo.DefaultTeam),
+		ReviewerCount:     repo.ReviewerCount,
+		MinReviewers:      repo.MinReviewers,
+		RequiredApprovals: repo.RequiredApprovals,
+		CreatedAt:         &created,
 	}
 }
 
 func toAPIUser(user domain.User) openapi.User {
+	skills := nonNilStrings(user.Skills)
+	role := openapi.UserRole(user.Role)
 	return openapi.User{
-		UserId:   user.ID,
-		Username: user.Username,
-		TeamName: user.TeamName,
-		IsActive: user.IsActive,
+		UserId:         user.ID,
+		Username:       user.Username,
+		TeamName:       user.TeamName,
+		IsActive:       user.IsActive,
+		Role:           &role,
+		Skills:         &skills,
+		MaxOpenReviews: user.MaxOpenReviews,
+		ReviewWeight:   &user.ReviewWeight,
+		Timezone:       optionalString(user.Timezone),
+		WorkStart:      optionalString(user.WorkStart),
+		WorkEnd:        optionalString(user.WorkEnd),
 	}
 }
 
@@ -263,23 +979,68 @@ func toAPIPullRequest(pr domain.PullRequest) openapi.PullRequest {
 	if pr.MergedAt != nil {
 		merged = pr.MergedAt
 	}
+	fallback := nonNilStrings(pr.FallbackReviewers)
+	pinned := nonNilStrings(pr.PinnedReviewers)
+	labels := nonNilStrings(pr.Labels)
+	reviews := toAPIReviews(pr.Reviews)
+	history := make([]openapi.ReviewRound, 0, len(pr.ReviewHistory))
+	for _, round := range pr.ReviewHistory {
+		history = append(history, openapi.ReviewRound{
+			Round:   round.Round,
+			Reviews: toAPIReviews(round.Reviews),
+		})
+	}
 	return openapi.PullRequest{
 		PullRequestId:     pr.ID,
 		PullRequestName:   pr.Name,
+		Version:           &pr.Version,
+		Description:       &pr.Description,
+		Repository:        &pr.Repository,
+		Number:            pr.Number,
+		Size:              toAPIDiffSize(pr.Size),
 		AuthorId:          pr.AuthorID,
 		Status:            openapi.PullRequestStatus(pr.Status),
 		AssignedReviewers: pr.AssignedReviewers,
+		FallbackReviewers: &fallback,
+		PinnedReviewers:   &pinned,
+		ReviewRound:       &pr.ReviewRound,
+		Reviews:           &reviews,
+		ReviewHistory:     &history,
+		MergeOverride:     &pr.MergeOverride,
+		Labels:            &labels,
 		CreatedAt:         &created,
 		MergedAt:          merged,
+		ClosedAt:          pr.ClosedAt,
+	}
+}
+
+func toAPIReviews(items []domain.Review) []openapi.Review {
+	reviews := make([]openapi.Review, 0, len(items))
+	for _, review := range items {
+		reviews = append(reviews, openapi.Review{
+			ReviewerId: review.ReviewerID,
+			Round:      review.Round,
+			State:      openapi.ReviewState(review.State),
+			AssignedAt: review.AssignedAt,
+			DecidedAt:  review.DecidedAt,
+		})
 	}
+	return reviews
 }
 
 func toAPIPullRequestShort(items []domain.PullRequestShort) []openapi.PullRequestShort {
 	result := make([]openapi.PullRequestShort, 0, len(items))
 	for _, item := range items {
+		labels := nonNilStrings(item.Labels)
 		result = append(result, openapi.PullRequestShort{
 			PullRequestId:   item.ID,
 			PullRequestName: item.Name,
+			Description:     &item.Description,
+			Repository:      &item.Repository,
+			Number:          item.Number,
+			Size:            toAPIDiffSize(item.Size),
+			Labels:          &labels,
+			WaitingRound:    item.WaitingRound,
 			AuthorId:        item.AuthorID,
 			Status:          openapi.PullRequestShortStatus(item.Status),
 		})
@@ -294,7 +1055,46 @@ func toAPIReassignments(items []service.ReassignmentChange) []apiReassignment {
 			PullRequestID: item.PullRequestID,
 			OldReviewerID: item.OldReviewerID,
 			NewReviewerID: item.NewReviewerID,
+			Reason:        replacementFailureCode(item.Failure),
 		})
 	}
 	return result
 }
+
+func nonNilStrings(items []string) []string {
+	if items == nil {
+		return []string{}
+	}
+	return items
+}
+
+func derefString(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
+
+func replacementFailureCode(err error) *openapi.ErrorResponseErrorCode {
+	var code openapi.ErrorResponseErrorCode
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, domain.ErrReviewersAtCapacity):
+		code = openapi.ATCAPACITY
+	case errors.Is(err, domain.ErrReviewerPinned):
+		code = openapi.REVIEWERPINNED
+	case errors.Is(err, domain.ErrReviewDecided):
+		code = openapi.REVIEWDECIDED
+	default:
+		code = openapi.NOCANDIDATE
+	}
+	return &code
+}
@@ -0,0 +1,90 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+const clockLayout = "15:04"
+
+type SetWorkingHoursInput struct {
+	UserID    string
+	Timezone  string
+	WorkStart string
+	WorkEnd   string
+}
+
+func (s *Service) SetUserWorkingHours(ctx context.Context, input SetWorkingHoursInput) (domain.User, error) {
+	if input.Timezone == "" {
+		input.Timezone = "UTC"
+	}
+	if _, err := time.LoadLocation(input.Timezone); err != nil {
+		return domain.User{}, domain.ErrInvalidInput
+	}
+	if (input.WorkStart == "") != (input.WorkEnd == "") {
+		return domain.User{}, domain.ErrInvalidInput
+	}
+	if input.WorkStart != "" {
+		start, err := time.Parse(clockLayout, input.WorkStart)
+		if err != nil {
+			return domain.User{}, domain.ErrInvalidInput
+		}
+		end, err := time.Parse(clockLayout, input.WorkEnd)
+		if err != nil || start.Equal(end) {
+			return domain.User{}, domain.ErrInvalidInput
+		}
+	}
+
+	ct, err := s.db.Exec(ctx, `
+        UPDATE users
+        SET timezone = $2,
+            work_start = NULLIF($3, '')::time,
+            work_end = NULLIF($4, '')::time
+        WHERE id = $1
+    `, input.UserID, input.Timezone, input.WorkStart, input.WorkEnd)
+	if err != nil {
+		return domain.User{}, err
+	}
+	if ct.RowsAffected() == 0 {
+		return domain.User{}, domain.ErrUserNotFound
+	}
+	return s.getUser(ctx, s.db, input.UserID)
+}
+
+// inWorkingHours reports whether a schedule covers now or starts within
+// window from now. Shifts may wrap past midnight (e.g. 22:00–06:00). Users
+// without a schedule are always considered available.
+func inWorkingHours(now time.Time, window time.Duration, timezone, workStart, workEnd string) bool {
+	if workStart == "" || workEnd == "" {
+		return true
+	}
+	loc, err := time.LoadLocation(timezone)
+	if err != nil {
+		return true
+	}
+	start, err := time.Parse(clockLayout, workStart)
+	if err != nil {
+		return true
+	}
+	end, err := time.Parse(clockLayout, workEnd)
+	if err != nil {
+		return true
+	}
+
+	const day = 24 * 60
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+
+	if from < to && minute >= from && minute < to {
+		return true
+	}
+	if from > to && (minute >= from || minute < to) {
+		return true
+	}
+	untilStart := (from - minute + day) % day
+	return time.Duration(untilStart)*time.Minute <= window
+}
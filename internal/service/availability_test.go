@@ -0,0 +1,46 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestInWorkingHours(t *testing.T) {
+	at := func(hour, minute int) time.Time {
+		return time.Date(2025, time.March, 10, hour, minute, 0, 0, time.UTC)
+	}
+
+	tests := []struct {
+		name     string
+		now      time.Time
+		window   time.Duration
+		timezone string
+		start    string
+		end      string
+		want     bool
+	}{
+		{name: "no schedule", now: at(3, 0), timezone: "UTC", want: true},
+		{name: "unknown timezone", now: at(3, 0), timezone: "Mars/Olympus", start: "09:00", end: "18:00", want: true},
+		{name: "malformed start", now: at(3, 0), timezone: "UTC", start: "9am", end: "18:00", want: true},
+		{name: "inside day shift", now: at(12, 0), timezone: "UTC", start: "09:00", end: "18:00", want: true},
+		{name: "at shift start", now: at(9, 0), timezone: "UTC", start: "09:00", end: "18:00", want: true},
+		{name: "at shift end", now: at(18, 0), timezone: "UTC", start: "09:00", end: "18:00", want: false},
+		{name: "before shift", now: at(7, 0), timezone: "UTC", start: "09:00", end: "18:00", want: false},
+		{name: "shift starts within window", now: at(8, 30), window: time.Hour, timezone: "UTC", start: "09:00", end: "18:00", want: true},
+		{name: "shift starts after window", now: at(7, 30), window: time.Hour, timezone: "UTC", start: "09:00", end: "18:00", want: false},
+		{name: "window across midnight", now: at(23, 30), window: 10 * time.Hour, timezone: "UTC", start: "09:00", end: "18:00", want: true},
+		{name: "night shift before midnight", now: at(23, 0), timezone: "UTC", start: "22:00", end: "06:00", want: true},
+		{name: "night shift after midnight", now: at(2, 0), timezone: "UTC", start: "22:00", end: "06:00", want: true},
+		{name: "outside night shift", now: at(12, 0), timezone: "UTC", start: "22:00", end: "06:00", want: false},
+		{name: "local time is used", now: at(6, 0), timezone: "Asia/Tokyo", start: "09:00", end: "18:00", want: true},
+		{name: "local time outside shift", now: at(12, 0), timezone: "Asia/Tokyo", start: "09:00", end: "18:00", want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := inWorkingHours(tt.now, tt.window, tt.timezone, tt.start, tt.end); got != tt.want {
+				t.Errorf("inWorkingHours = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
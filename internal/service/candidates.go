@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+const (
+	scoreSkillMatch    = 10
+	scoreRecentPairing = -5
+	scoreOpenReview    = -2
+	scoreFallbackTeam  = -3
+	scoreOffHours      = -8
+)
+
+type RankedCandidate struct {
+	UserID         string
+	TeamName       string
+	Role           string
+	Score          int
+	OpenReviews    int
+	MaxOpenReviews *int
+	MatchedSkills  []string
+	RecentPairings int
+	Fallback       bool
+	Available      bool
+	Reasons        []string
+}
+
+// RankReplacementCandidates lists everyone who could take a reviewer slot on
+// an open pull request, best first. With oldReviewerID set the candidates are
+// drawn from that reviewer's team, as in ReassignReviewer; otherwise from the
+// team reviewing the pull request. Fallback teams are always included.
+func (s *Service) RankReplacementCandidates(ctx context.Context, prID, oldReviewerID string) ([]RankedCandidate, error) {
+	pr, err := s.GetPullRequest(ctx, s.db, prID)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureNotFinished(pr); err != nil {
+		return nil, err
+	}
+
+	_, settings, err := s.pullRequestSettings(ctx, s.db, prID)
+	if err != nil {
+		return nil, err
+	}
+	teamName := settings.TeamName
+	if oldReviewerID != "" {
+		assigned := false
+		for _, id := range pr.AssignedReviewers {
+			if id == oldReviewerID {
+				assigned = true
+				break
+			}
+		}
+		if !assigned {
+			return nil, domain.ErrReviewerNotAssigned
+		}
+		oldUser, err := s.getUser(ctx, s.db, oldReviewerID)
+		if err != nil {
+			return nil, err
+		}
+		teamName = oldUser.TeamName
+	}
+
+	pairings, err := s.pullRequestPairings(ctx, s.db, prID)
+	if err != nil {
+		return nil, err
+	}
+	fallbackTeams, err := s.listFallbackTeams(ctx, s.db, teamName)
+	if err != nil {
+		return nil, err
+	}
+	seniorOnly := false
+	if oldReviewerID != "" {
+		if seniorOnly, err = s.seniorRequired(ctx, s.db, prID, oldReviewerID); err != nil {
+			return nil, err
+		}
+	}
+
+	declined, err := s.listDeclinedReviewers(ctx, s.db, prID)
+	if err != nil {
+		return nil, err
+	}
+	excluded := append(append(append([]string{}, pr.AssignedReviewers...), declined...), pr.AuthorID)
+	ranked := make([]RankedCandidate, 0)
+	for i, team := range append([]string{teamName}, fallbackTeams...) {
+		candidates, err := s.pickReplacementCandidates(ctx, s.db, team, excluded, oldReviewerID, seniorOnly, make(map[string]struct{}))
+		if err != nil {
+			return nil, err
+		}
+		for _, c := range withPairings(candidates, pairings) {
+			ranked = append(ranked, rankCandidate(c, pr.Labels, i > 0))
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		if ranked[i].Score != ranked[j].Score {
+			return ranked[i].Score > ranked[j].Score
+		}
+		return ranked[i].UserID < ranked[j].UserID
+	})
+	return ranked, nil
+}
+
+func rankCandidate(c Candidate, labels []string, fallback bool) RankedCandidate {
+	ranked := RankedCandidate{
+		UserID:         c.UserID,
+		TeamName:       c.TeamName,
+		Role:           c.Role,
+		OpenReviews:    c.OpenReviews,
+		MaxOpenReviews: c.MaxOpenReviews,
+		MatchedSkills:  make([]string, 0),
+		RecentPairings: c.RecentPairings,
+		Fallback:       fallback,
+		Available:      c.Available,
+	}
+
+	for _, skill := range c.Skills {
+		for _, label := range labels {
+			if skill == label {
+				ranked.MatchedSkills = append(ranked.MatchedSkills, skill)
+			}
+		}
+	}
+	if len(ranked.MatchedSkills) > 0 {
+		ranked.Score += scoreSkillMatch * len(ranked.MatchedSkills)
+		ranked.Reasons = append(ranked.Reasons, fmt.Sprintf("skills match labels: %s", strings.Join(ranked.MatchedSkills, ", ")))
+	} else if len(labels) > 0 {
+		ranked.Reasons = append(ranked.Reasons, "no skills matching labels")
+	}
+
+	ranked.Score += scoreOpenReview * c.OpenReviews
+	ranked.Reasons = append(ranked.Reasons, fmt.Sprintf("%d open reviews", c.OpenReviews))
+	if c.MaxOpenReviews != nil {
+		ranked.Reasons = append(ranked.Reasons, fmt.Sprintf("%d of %d review slots free", *c.MaxOpenReviews-c.OpenReviews, *c.MaxOpenReviews))
+	}
+
+	if c.RecentPairings > 0 {
+		ranked.Score += scoreRecentPairing * c.RecentPairings
+		ranked.Reasons = append(ranked.Reasons, fmt.Sprintf("reviewed the author %d times recently", c.RecentPairings))
+	} else {
+		ranked.Reasons = append(ranked.Reasons, "not paired with the author recently")
+	}
+
+	if c.Weight == 0 {
+		ranked.Reasons = append(ranked.Reasons, "review weight 0: never auto-assigned, manual pick only")
+	} else if c.Weight != 1 {
+		ranked.Reasons = append(ranked.Reasons, fmt.Sprintf("review weight %d", c.Weight))
+	}
+
+	ranked.Reasons = append(ranked.Reasons, fmt.Sprintf("role %s", c.Role))
+
+	if !c.Available {
+		ranked.Score += scoreOffHours
+		ranked.Reasons = append(ranked.Reasons, "outside working hours")
+	}
+
+	if fallback {
+		ranked.Score += scoreFallbackTeam
+		ranked.Reasons = append(ranked.Reasons, fmt.Sprintf("from fallback team %s", c.TeamName))
+	} else {
+		ranked.Reasons = append(ranked.Reasons, fmt.Sprintf("from team %s", c.TeamName))
+	}
+	return ranked
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+type DeclineInput struct {
+	PullRequestID string
+	ReviewerID    string
+	Reason        string
+}
+
+type DeclineResult struct {
+	PullRequest domain.PullRequest
+	ReplacedBy  *string
+	Failure     error
+}
+
+// DeclineReview removes a reviewer at their own request and picks a
+// replacement the same way ReassignReviewer does. The decline is recorded,
+// so the reviewer is never picked for this pull request again. When no
+// replacement is available the reviewer is still removed.
+func (s *Service) DeclineReview(ctx context.Context, input DeclineInput) (DeclineResult, error) {
+	if !validDeclineReason(input.Reason) {
+		return DeclineResult{}, domain.ErrInvalidInput
+	}
+
+	var result DeclineResult
+	err := s.withTx(ctx, func(tx pgx.Tx) error {
+		pr, err := s.GetPullRequest(ctx, tx, input.PullRequestID)
+		if err != nil {
+			return err
+		}
+		if err := ensureNotFinished(pr); err != nil {
+			return err
+		}
+
+		hasReviewer := false
+		for _, reviewer := range pr.AssignedReviewers {
+			if reviewer == input.ReviewerID {
+				hasReviewer = true
+				break
+			}
+		}
+		if !hasReviewer {
+			return domain.ErrReviewerNotAssigned
+		}
+
+		reviewer, err := s.getUser(ctx, tx, input.ReviewerID)
+		if err != nil {
+			return err
+		}
+
+		if _, err := tx.Exec(ctx, `
+            INSERT INTO review_declines (pull_request_id, reviewer_id, reason, declined_at)
+            VALUES ($1, $2, $3, $4)
+            ON CONFLICT (pull_request_id, reviewer_id) DO UPDATE
+            SET reason = EXCLUDED.reason,
+                declined_at = EXCLUDED.declined_at
+        `, input.PullRequestID, input.ReviewerID, input.Reason, s.now()); err != nil {
+			return err
+		}
+
+		choice, err := s.pickReplacement(ctx, tx, input.PullRequestID, reviewer.TeamName, pr.AuthorID, pr.AssignedReviewers, input.ReviewerID)
+		switch {
+		case err == nil:
+			result.ReplacedBy = &choice.UserID
+			if err := s.replaceReviewer(ctx, tx, input.PullRequestID, input.ReviewerID, choice); err != nil {
+				return err
+			}
+		case errors.Is(err, domain.ErrNoCandidate), errors.Is(err, domain.ErrNoSeniorCandidate),
+			errors.Is(err, domain.ErrReviewersAtCapacity):
+			result.Failure = err
+			if _, err := s.dropReviewer(ctx, tx, input.PullRequestID, input.ReviewerID); err != nil {
+				return err
+			}
+		default:
+			return err
+		}
+
+		updated, err := s.GetPullRequest(ctx, tx, input.PullRequestID)
+		if err != nil {
+			return err
+		}
+		result.PullRequest = updated
+		return nil
+	})
+	if err != nil {
+		return DeclineResult{}, err
+	}
+	return result, nil
+}
+
+func (s *Service) listDeclinedReviewers(ctx context.Context, q dbExecutor, prID string) ([]string, error) {
+	rows, err := q.Query(ctx, `
+        SELECT reviewer_id
+        FROM review_declines
+        WHERE pull_request_id = $1
+        ORDER BY reviewer_id
+    `, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var reviewers []string
+	for rows.Next() {
+		var reviewer string
+		if err := rows.Scan(&reviewer); err != nil {
+			return nil, err
+		}
+		reviewers = append(reviewers, reviewer)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return reviewers, nil
+}
+
+func validDeclineReason(reason string) bool {
+	switch reason {
+	case domain.DeclineReasonBusy, domain.DeclineReasonConflictOfInterest, domain.DeclineReasonLacksContext:
+		return true
+	default:
+		return false
+	}
+}
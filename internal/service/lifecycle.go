@@ -0,0 +1,165 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+// pullRequestTransitions lists the statuses each status can move to through
+// the ready, close and reopen endpoints. Merging is handled separately.
+var pullRequestTransitions = map[string][]string{
+	domain.PullRequestStatusDraft:  {domain.PullRequestStatusOpen, domain.PullRequestStatusClosed},
+	domain.PullRequestStatusOpen:   {domain.PullRequestStatusClosed},
+	domain.PullRequestStatusClosed: {domain.PullRequestStatusOpen},
+}
+
+// MarkPullRequestReady moves a DRAFT pull request to OPEN and assigns its
+// reviewers the same way CreatePullRequest does.
+func (s *Service) MarkPullRequestReady(ctx context.Context, prID string) (CreatePullRequestResult, error) {
+	return s.openPullRequest(ctx, prID, domain.PullRequestStatusDraft)
+}
+
+// ReopenPullRequest moves a CLOSED pull request back to OPEN and assigns a
+// fresh set of reviewers.
+func (s *Service) ReopenPullRequest(ctx context.Context, prID string) (CreatePullRequestResult, error) {
+	return s.openPullRequest(ctx, prID, domain.PullRequestStatusClosed)
+}
+
+func (s *Service) openPullRequest(ctx context.Context, prID, from string) (CreatePullRequestResult, error) {
+	var warnings []string
+	err := s.withTx(ctx, func(tx pgx.Tx) error {
+		pr, err := s.GetPullRequest(ctx, tx, prID)
+		if err != nil {
+			return err
+		}
+		if pr.Status != from {
+			return transitionError(pr.Status, domain.PullRequestStatusOpen)
+		}
+		author, err := s.getUser(ctx, tx, pr.AuthorID)
+		if err != nil {
+			return err
+		}
+		if err := s.checkOpenPRLimit(ctx, tx, author); err != nil {
+			return err
+		}
+		if err := s.setPullRequestStatus(ctx, tx, prID, domain.PullRequestStatusOpen); err != nil {
+			return err
+		}
+
+		paths, err := s.listPullRequestFiles(ctx, tx, prID)
+		if err != nil {
+			return err
+		}
+		warnings, err = s.assignReviewers(ctx, tx, prID, author, paths, pr.Labels)
+		return err
+	})
+	if err != nil {
+		return CreatePullRequestResult{}, err
+	}
+
+	pr, err := s.GetPullRequest(ctx, s.db, prID)
+	if err != nil {
+		return CreatePullRequestResult{}, err
+	}
+	return CreatePullRequestResult{PullRequest: pr, Warnings: warnings}, nil
+}
+
+// ClosePullRequest abandons a DRAFT or OPEN pull request without merging it
+// and releases its reviewers.
+func (s *Service) ClosePullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
+	err := s.withTx(ctx, func(tx pgx.Tx) error {
+		pr, err := s.GetPullRequest(ctx, tx, prID)
+		if err != nil {
+			return err
+		}
+		if !canTransition(pr.Status, domain.PullRequestStatusClosed) {
+			return transitionError(pr.Status, domain.PullRequestStatusClosed)
+		}
+
+		if err := s.archiveReviews(ctx, tx, prID, ""); err != nil {
+			return err
+		}
+		if _, err := tx.Exec(ctx, `DELETE FROM pull_request_reviewers WHERE pull_request_id = $1`, prID); err != nil {
+			return err
+		}
+		return s.setPullRequestStatus(ctx, tx, prID, domain.PullRequestStatusClosed)
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	return s.GetPullRequest(ctx, s.db, prID)
+}
+
+// checkOpenPRLimit fails when the author already has as many OPEN pull
+// requests as the team's max_open_pull_requests allows (0 means no limit).
+// The author row is locked so concurrent requests cannot both pass.
+func (s *Service) checkOpenPRLimit(ctx context.Context, tx pgx.Tx, author domain.User) error {
+	settings, err := s.getTeamSettings(ctx, tx, author.TeamName)
+	if err != nil {
+		return err
+	}
+	if settings.MaxOpenPRs == 0 {
+		return nil
+	}
+
+	if _, err := tx.Exec(ctx, `SELECT 1 FROM users WHERE id = $1 FOR UPDATE`, author.ID); err != nil {
+		return err
+	}
+	var open int
+	err = tx.QueryRow(ctx, `
+        SELECT COUNT(*)
+        FROM pull_requests
+        WHERE author_id = $1 AND status = 'OPEN'
+    `, author.ID).Scan(&open)
+	if err != nil {
+		return err
+	}
+	if open >= settings.MaxOpenPRs {
+		return fmt.Errorf("%w: limit %d, open %d", domain.ErrUserHasOpenPR, settings.MaxOpenPRs, open)
+	}
+	return nil
+}
+
+func (s *Service) setPullRequestStatus(ctx context.Context, q dbExecutor, prID, status string) error {
+	var closedAt *time.Time
+	if status == domain.PullRequestStatusClosed {
+		now := s.now()
+		closedAt = &now
+	}
+	_, err := q.Exec(ctx, `
+        UPDATE pull_requests
+        SET status = $2, closed_at = $3, version = version + 1
+        WHERE id = $1
+    `, prID, status, closedAt)
+	return err
+}
+
+func canTransition(from, to string) bool {
+	for _, status := range pullRequestTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+func transitionError(from, to string) error {
+	return fmt.Errorf("%w: %s -> %s", domain.ErrInvalidTransition, from, to)
+}
+
+// ensureNotFinished rejects changes to reviewers and labels of MERGED and
+// CLOSED pull requests.
+func ensureNotFinished(pr domain.PullRequest) error {
+	switch pr.Status {
+	case domain.PullRequestStatusMerged:
+		return domain.ErrPullRequestMerged
+	case domain.PullRequestStatusClosed:
+		return domain.ErrPullRequestClosed
+	}
+	return nil
+}
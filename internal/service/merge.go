@@ -0,0 +1,58 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+type MergeInput struct {
+	PullRequestID  string
+	Override       bool
+	OverrideReason string
+}
+
+type mergeGate struct {
+	Approvals         int
+	RequiredApprovals int
+	ChangesRequested  int
+}
+
+func (g mergeGate) err() error {
+	if g.Approvals >= g.RequiredApprovals && g.ChangesRequested == 0 {
+		return nil
+	}
+	return fmt.Errorf("%w: %d of %d required approvals, %d change requests",
+		domain.ErrNotApproved, g.Approvals, g.RequiredApprovals, g.ChangesRequested)
+}
+
+// checkMergeGate compares the reviewer decisions on a pull request with the
+// approvals required for it: the settings of the repository default team (or
+// the author's team) with the repository overrides applied.
+func (s *Service) checkMergeGate(ctx context.Context, q dbExecutor, prID string) (mergeGate, error) {
+	_, settings, err := s.pullRequestSettings(ctx, q, prID)
+	if err != nil {
+		return mergeGate{}, err
+	}
+
+	gate := mergeGate{RequiredApprovals: settings.RequiredApprovals}
+	err = q.QueryRow(ctx, `
+        SELECT COUNT(*) FILTER (WHERE state = 'APPROVED'),
+               COUNT(*) FILTER (WHERE state = 'CHANGES_REQUESTED')
+        FROM pull_request_reviewers
+        WHERE pull_request_id = $1
+    `, prID).Scan(&gate.Approvals, &gate.ChangesRequested)
+	if err != nil {
+		return mergeGate{}, err
+	}
+	return gate, nil
+}
+
+func (s *Service) recordMergeOverride(ctx context.Context, q dbExecutor, prID string, gate mergeGate, reason string) error {
+	_, err := q.Exec(ctx, `
+        INSERT INTO merge_overrides (pull_request_id, approvals, required_approvals, changes_requested, reason, created_at)
+        VALUES ($1, $2, $3, $4, $5, $6)
+    `, prID, gate.Approvals, gate.RequiredApprovals, gate.ChangesRequested, reason, s.now())
+	return err
+}
@@ -0,0 +1,136 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+// UpdatePullRequestInput changes pull request metadata. Nil fields are left
+// as they are; Labels replaces the whole label set when not nil. When Version
+// is set the update only applies if the pull request is still at it.
+type UpdatePullRequestInput struct {
+	PullRequestID string
+	Version       *int
+	Name          *string
+	Description   *string
+	Repository    *string
+	Labels        []string
+	Size          *domain.DiffSize
+}
+
+func (s *Service) UpdatePullRequest(ctx context.Context, input UpdatePullRequestInput) (domain.PullRequest, error) {
+	if input.Name != nil && strings.TrimSpace(*input.Name) == "" {
+		return domain.PullRequest{}, domain.ErrInvalidInput
+	}
+	if !validDiffSize(input.Size) {
+		return domain.PullRequest{}, domain.ErrInvalidInput
+	}
+
+	var result domain.PullRequest
+	err := s.withTx(ctx, func(tx pgx.Tx) error {
+		pr, err := s.GetPullRequest(ctx, tx, input.PullRequestID)
+		if err != nil {
+			return err
+		}
+		if err := ensureNotFinished(pr); err != nil {
+			return err
+		}
+		version := pr.Version
+		if input.Version != nil {
+			version = *input.Version
+		}
+
+		if input.Name != nil {
+			pr.Name = *input.Name
+		}
+		if input.Description != nil {
+			pr.Description = *input.Description
+		}
+		if input.Repository != nil {
+			current := pr.Repository
+			pr.Repository = strings.TrimSpace(*input.Repository)
+			if pr.Repository == "" {
+				pr.Repository = domain.DefaultRepository
+			}
+			// The number and the derived ID belong to the repository.
+			if pr.Number != nil && pr.Repository != current {
+				return domain.ErrInvalidInput
+			}
+			if _, err := s.getRepository(ctx, tx, pr.Repository); err != nil {
+				return err
+			}
+		}
+		if input.Size != nil {
+			pr.Size = input.Size
+		}
+		if err := s.savePullRequestMetadata(ctx, tx, pr, version); err != nil {
+			return err
+		}
+		if input.Labels != nil {
+			if err := s.replacePullRequestLabels(ctx, tx, pr.ID, normalizeTags(input.Labels)); err != nil {
+				return err
+			}
+		}
+
+		result, err = s.GetPullRequest(ctx, tx, pr.ID)
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	return result, nil
+}
+
+// savePullRequestMetadata writes the metadata and bumps the version. The
+// version check is part of the UPDATE so that concurrent writers cannot both
+// pass it.
+func (s *Service) savePullRequestMetadata(ctx context.Context, q dbExecutor, pr domain.PullRequest, version int) error {
+	var added, removed, files *int
+	if pr.Size != nil {
+		added, removed, files = &pr.Size.LinesAdded, &pr.Size.LinesRemoved, &pr.Size.FilesChanged
+	}
+	ct, err := q.Exec(ctx, `
+        UPDATE pull_requests
+        SET name = $2, description = $3, repository = $4,
+            lines_added = $5, lines_removed = $6, files_changed = $7,
+            version = version + 1
+        WHERE id = $1 AND version = $8
+    `, pr.ID, pr.Name, pr.Description, pr.Repository, added, removed, files, version)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.ErrPullRequestExists
+		}
+		return err
+	}
+	if ct.RowsAffected() == 0 {
+		return fmt.Errorf("%w: expected %d, current %d", domain.ErrVersionMismatch, version, pr.Version)
+	}
+	return nil
+}
+
+func (s *Service) bumpPullRequestVersion(ctx context.Context, q dbExecutor, prID string) error {
+	_, err := q.Exec(ctx, `UPDATE pull_requests SET version = version + 1 WHERE id = $1`, prID)
+	return err
+}
+
+// diffSize builds a DiffSize from nullable columns; all three are either set
+// or NULL together.
+func diffSize(added, removed, files *int) *domain.DiffSize {
+	if added == nil || removed == nil || files == nil {
+		return nil
+	}
+	return &domain.DiffSize{LinesAdded: *added, LinesRemoved: *removed, FilesChanged: *files}
+}
+
+func validDiffSize(size *domain.DiffSize) bool {
+	return size == nil || (size.LinesAdded >= 0 && size.LinesRemoved >= 0 && size.FilesChanged >= 0)
+}
+
+func (s *Service) FetchPullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
+	return s.GetPullRequest(ctx, s.db, prID)
+}
@@ -0,0 +1,313 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+func (s *Service) AddOwnershipRule(ctx context.Context, rule domain.OwnershipRule) (domain.OwnershipRule, error) {
+	if rule.Pattern == "" || (rule.UserID == "") == (rule.TeamName == "") {
+		return domain.OwnershipRule{}, domain.ErrInvalidInput
+	}
+	if _, err := compileGlob(rule.Pattern); err != nil {
+		return domain.OwnershipRule{}, domain.ErrInvalidInput
+	}
+
+	if rule.UserID != "" {
+		if _, err := s.getUser(ctx, s.db, rule.UserID); err != nil {
+			return domain.OwnershipRule{}, err
+		}
+	} else {
+		var exists bool
+		if err := s.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE name = $1)`, rule.TeamName).Scan(&exists); err != nil {
+			return domain.OwnershipRule{}, err
+		}
+		if !exists {
+			return domain.OwnershipRule{}, domain.ErrTeamNotFound
+		}
+	}
+
+	err := s.db.QueryRow(ctx, `
+        INSERT INTO ownership_rules (pattern, owner_user_id, owner_team_name)
+        VALUES ($1, NULLIF($2, ''), NULLIF($3, ''))
+        RETURNING id
+    `, rule.Pattern, rule.UserID, rule.TeamName).Scan(&rule.ID)
+	if err != nil {
+		return domain.OwnershipRule{}, err
+	}
+	return rule, nil
+}
+
+func (s *Service) ListOwnershipRules(ctx context.Context) ([]domain.OwnershipRule, error) {
+	return s.listOwnershipRules(ctx, s.db)
+}
+
+func (s *Service) DeleteOwnershipRule(ctx context.Context, ruleID int64) error {
+	ct, err := s.db.Exec(ctx, `DELETE FROM ownership_rules WHERE id = $1`, ruleID)
+	if err != nil {
+		return err
+	}
+	if ct.RowsAffected() == 0 {
+		return domain.ErrOwnershipRuleNotFound
+	}
+	return nil
+}
+
+func (s *Service) listOwnershipRules(ctx context.Context, q dbExecutor) ([]domain.OwnershipRule, error) {
+	rows, err := q.Query(ctx, `
+        SELECT id, pattern, COALESCE(owner_user_id, ''), COALESCE(owner_team_name, '')
+        FROM ownership_rules
+        ORDER BY id
+    `)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	rules := make([]domain.OwnershipRule, 0)
+	for rows.Next() {
+		var rule domain.OwnershipRule
+		if err := rows.Scan(&rule.ID, &rule.Pattern, &rule.UserID, &rule.TeamName); err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return rules, nil
+}
+
+// resolvePathOwners maps every path covered by a rule to the active users
+// owning it. As in CODEOWNERS, the last matching rule wins. Paths without a
+// matching rule are absent from the result.
+func (s *Service) resolvePathOwners(ctx context.Context, q dbExecutor, paths []string) (map[string][]string, error) {
+	owners := make(map[string][]string)
+	if len(paths) == 0 {
+		return owners, nil
+	}
+
+	rules, err := s.listOwnershipRules(ctx, q)
+	if err != nil {
+		return nil, err
+	}
+	globs := make([]*regexp.Regexp, len(rules))
+	for i, rule := range rules {
+		if globs[i], err = compileGlob(rule.Pattern); err != nil {
+			return nil, err
+		}
+	}
+
+	teamMembers := make(map[string][]string)
+	for _, path := range paths {
+		var matched *domain.OwnershipRule
+		for i := range rules {
+			if globs[i].MatchString(path) {
+				matched = &rules[i]
+			}
+		}
+		if matched == nil {
+			continue
+		}
+
+		if matched.UserID != "" {
+			user, err := s.getUser(ctx, q, matched.UserID)
+			if err != nil && !errors.Is(err, domain.ErrUserNotFound) {
+				return nil, err
+			}
+			if err == nil && user.IsActive {
+				owners[path] = []string{user.ID}
+			} else {
+				owners[path] = []string{}
+			}
+			continue
+		}
+
+		members, ok := teamMembers[matched.TeamName]
+		if !ok {
+			members, err = s.listActiveTeamMembers(ctx, q, matched.TeamName)
+			if err != nil {
+				return nil, err
+			}
+			teamMembers[matched.TeamName] = members
+		}
+		owners[path] = members
+	}
+	return owners, nil
+}
+
+func (s *Service) listActiveTeamMembers(ctx context.Context, q dbExecutor, teamName string) ([]string, error) {
+	rows, err := q.Query(ctx, `
+        SELECT id
+        FROM users
+        WHERE team_name = $1 AND is_active = true
+    `, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	members := make([]string, 0)
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		members = append(members, id)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return members, nil
+}
+
+func (s *Service) savePullRequestFiles(ctx context.Context, q dbExecutor, prID string, paths []string) error {
+	for _, path := range paths {
+		if _, err := q.Exec(ctx, `
+            INSERT INTO pull_request_files (pull_request_id, path)
+            VALUES ($1, $2)
+            ON CONFLICT DO NOTHING
+        `, prID, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) listPullRequestFiles(ctx context.Context, q dbExecutor, prID string) ([]string, error) {
+	rows, err := q.Query(ctx, `
+        SELECT path
+        FROM pull_request_files
+        WHERE pull_request_id = $1
+        ORDER BY path
+    `, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var paths []string
+	for rows.Next() {
+		var path string
+		if err := rows.Scan(&path); err != nil {
+			return nil, err
+		}
+		paths = append(paths, path)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return paths, nil
+}
+
+func isCovered(pathOwners []string, reviewers map[string]struct{}) bool {
+	for _, id := range pathOwners {
+		if _, ok := reviewers[id]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func uncoveredOwners(owners map[string][]string, reviewers map[string]struct{}) map[string][]string {
+	uncovered := make(map[string][]string)
+	for path, pathOwners := range owners {
+		if !isCovered(pathOwners, reviewers) {
+			uncovered[path] = pathOwners
+		}
+	}
+	return uncovered
+}
+
+// ownedOnlyBy returns the owner lists of the paths that leaving owns and none
+// of remaining does, i.e. the paths that lose their owner if leaving goes.
+func ownedOnlyBy(owners map[string][]string, remaining map[string]struct{}, leaving string) [][]string {
+	var lost [][]string
+	for _, path := range sortedPaths(owners) {
+		pathOwners := owners[path]
+		if isCovered(pathOwners, map[string]struct{}{leaving: {}}) && !isCovered(pathOwners, remaining) {
+			lost = append(lost, pathOwners)
+		}
+	}
+	return lost
+}
+
+func sortedPaths(owners map[string][]string) []string {
+	paths := make([]string, 0, len(owners))
+	for path := range owners {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+// compileGlob turns a CODEOWNERS-style pattern into a regexp: "**" spans
+// directories, "*" and "?" stay within one path segment. As in CODEOWNERS, a
+// pattern without a slash ("*.go") matches at any depth, a leading slash or a
+// slash in the middle anchors it to the repository root, and a pattern naming
+// a directory ("payments/" or "payments") matches everything under it.
+func compileGlob(pattern string) (*regexp.Regexp, error) {
+	anchored := strings.HasPrefix(pattern, "/")
+	pattern = strings.TrimPrefix(pattern, "/")
+	dir := strings.HasSuffix(pattern, "/")
+	pattern = strings.TrimSuffix(pattern, "/")
+	if pattern == "" {
+		return nil, errors.New("empty pattern")
+	}
+
+	var b strings.Builder
+	b.WriteString("^")
+	if !anchored && !strings.Contains(pattern, "/") {
+		b.WriteString("(?:.*/)?")
+	}
+	for i := 0; i < len(pattern); i++ {
+		switch ch := pattern[i]; ch {
+		case '*':
+			if i+1 < len(pattern) && pattern[i+1] == '*' {
+				i++
+				if i+1 < len(pattern) && pattern[i+1] == '/' {
+					i++
+					b.WriteString("(?:.*/)?")
+				} else {
+					b.WriteString(".*")
+				}
+			} else {
+				b.WriteString("[^/]*")
+			}
+		case '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(ch)))
+		}
+	}
+	switch {
+	case dir:
+		b.WriteString("/.*")
+	case !strings.HasSuffix(pattern, "*"):
+		b.WriteString("(?:/.*)?")
+	}
+	b.WriteString("$")
+	return regexp.Compile(b.String())
+}
+
+func normalizePaths(paths []string) []string {
+	seen := make(map[string]struct{}, len(paths))
+	result := make([]string, 0, len(paths))
+	for _, path := range paths {
+		path = strings.TrimPrefix(strings.TrimSpace(path), "/")
+		if path == "" {
+			continue
+		}
+		if _, ok := seen[path]; ok {
+			continue
+		}
+		seen[path] = struct{}{}
+		result = append(result, path)
+	}
+	return result
+}
@@ -0,0 +1,55 @@
+package service
+
+import "testing"
+
+func TestCompileGlob(t *testing.T) {
+	tests := []struct {
+		pattern string
+		path    string
+		want    bool
+	}{
+		{"*.go", "main.go", true},
+		{"*.go", "internal/service/service.go", true},
+		{"*.go", "main.go.txt", false},
+		{"/*.go", "main.go", true},
+		{"/*.go", "internal/main.go", false},
+		{"payments/", "payments/api.go", true},
+		{"payments/", "payments/internal/ledger.go", true},
+		{"payments/", "services/payments/api.go", true},
+		{"payments/", "payments", false},
+		{"/payments/", "services/payments/api.go", false},
+		{"payments", "payments/api.go", true},
+		{"payments", "payments", true},
+		{"payments", "payments-v2/api.go", false},
+		{"services/payments", "services/payments/api.go", true},
+		{"services/payments", "legacy/services/payments/api.go", false},
+		{"docs/*", "docs/readme.md", true},
+		{"docs/*", "docs/guides/setup.md", false},
+		{"docs/**", "docs/guides/setup.md", true},
+		{"**/migrations/*.sql", "migrations/0001_init.up.sql", true},
+		{"**/migrations/*.sql", "db/migrations/0001_init.up.sql", true},
+		{"api/?.yaml", "api/a.yaml", true},
+		{"api/?.yaml", "api/ab.yaml", false},
+		{"*", "any/path/at/all.txt", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.pattern+" "+tt.path, func(t *testing.T) {
+			re, err := compileGlob(tt.pattern)
+			if err != nil {
+				t.Fatalf("compileGlob(%q): %v", tt.pattern, err)
+			}
+			if got := re.MatchString(tt.path); got != tt.want {
+				t.Errorf("%q matches %q = %v, want %v (regexp %s)", tt.pattern, tt.path, got, tt.want, re)
+			}
+		})
+	}
+}
+
+func TestCompileGlobRejectsEmptyPattern(t *testing.T) {
+	for _, pattern := range []string{"", "/", "//"} {
+		if _, err := compileGlob(pattern); err == nil {
+			t.Errorf("compileGlob(%q) succeeded, want error", pattern)
+		}
+	}
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+func (s *Service) GetPairingStats(ctx context.Context, teamName string, days int) (domain.PairingStats, error) {
+	if days < 0 {
+		return domain.PairingStats{}, domain.ErrInvalidInput
+	}
+
+	var exists bool
+	if err := s.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE name = $1)`, teamName).Scan(&exists); err != nil {
+		return domain.PairingStats{}, err
+	}
+	if !exists {
+		return domain.PairingStats{}, domain.ErrTeamNotFound
+	}
+
+	var since *time.Time
+	if days > 0 {
+		t := s.now().AddDate(0, 0, -days)
+		since = &t
+	}
+
+	rows, err := s.db.Query(ctx, `
+        SELECT p.author_id, p.reviewer_id, COUNT(DISTINCT p.pull_request_id)
+        FROM review_pairings p
+        JOIN users author ON author.id = p.author_id
+        WHERE author.team_name = $1 AND ($2::timestamptz IS NULL OR p.assigned_at >= $2)
+        GROUP BY p.author_id, p.reviewer_id
+        ORDER BY p.author_id, p.reviewer_id
+    `, teamName, since)
+	if err != nil {
+		return domain.PairingStats{}, err
+	}
+	defer rows.Close()
+
+	stats := domain.PairingStats{TeamName: teamName, Days: days, Pairings: make([]domain.Pairing, 0)}
+	for rows.Next() {
+		var pairing domain.Pairing
+		if err := rows.Scan(&pairing.AuthorID, &pairing.ReviewerID, &pairing.Count); err != nil {
+			return domain.PairingStats{}, err
+		}
+		stats.Pairings = append(stats.Pairings, pairing)
+	}
+	if rows.Err() != nil {
+		return domain.PairingStats{}, rows.Err()
+	}
+	return stats, nil
+}
+
+func (s *Service) recordPairing(ctx context.Context, q dbExecutor, prID, reviewerID string) error {
+	_, err := q.Exec(ctx, `
+        INSERT INTO review_pairings (pull_request_id, author_id, reviewer_id, assigned_at)
+        SELECT id, author_id, $2, $3
+        FROM pull_requests
+        WHERE id = $1
+    `, prID, reviewerID, s.now())
+	return err
+}
+
+// recentPairings counts, per reviewer, the author's other pull requests they
+// were assigned to within the team's pairing window (last N PRs or days).
+func (s *Service) recentPairings(ctx context.Context, q dbExecutor, prID, authorID string, settings domain.TeamSettings) (map[string]int, error) {
+	counts := make(map[string]int)
+	if settings.PairingWindowPRs == 0 && settings.PairingWindowDays == 0 {
+		return counts, nil
+	}
+
+	var since *time.Time
+	if settings.PairingWindowDays > 0 {
+		t := s.now().AddDate(0, 0, -settings.PairingWindowDays)
+		since = &t
+	}
+
+	rows, err := q.Query(ctx, `
+        SELECT p.reviewer_id, COUNT(DISTINCT p.pull_request_id)
+        FROM review_pairings p
+        WHERE p.author_id = $1 AND p.pull_request_id <> $2
+          AND (
+              p.pull_request_id IN (
+                  SELECT id
+                  FROM pull_requests
+                  WHERE author_id = $1 AND id <> $2
+                  ORDER BY created_at DESC
+                  LIMIT $3
+              )
+              OR ($4::timestamptz IS NOT NULL AND p.assigned_at >= $4)
+          )
+        GROUP BY p.reviewer_id
+    `, authorID, prID, settings.PairingWindowPRs, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var reviewer string
+		var count int
+		if err := rows.Scan(&reviewer, &count); err != nil {
+			return nil, err
+		}
+		counts[reviewer] = count
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return counts, nil
+}
+
+func (s *Service) pullRequestPairings(ctx context.Context, q dbExecutor, prID string) (map[string]int, error) {
+	authorID, settings, err := s.pullRequestSettings(ctx, q, prID)
+	if err != nil {
+		return nil, err
+	}
+	return s.recentPairings(ctx, q, prID, authorID, settings)
+}
+
+func withPairings(candidates []Candidate, pairings map[string]int) []Candidate {
+	for i := range candidates {
+		candidates[i].RecentPairings = pairings[candidates[i].UserID]
+	}
+	return candidates
+}
@@ -0,0 +1,233 @@
+package service
+
+import (
+	"context"
+	"sort"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+const defaultRebalanceThreshold = 1
+
+type RebalanceInput struct {
+	TeamName  string
+	Threshold *int
+	DryRun    bool
+}
+
+type RebalanceResult struct {
+	TeamName      string
+	Average       float64
+	Threshold     int
+	DryRun        bool
+	Reassignments []ReassignmentChange
+}
+
+// RebalanceTeam moves OPEN reviews from members whose load exceeds the team
+// average by more than the threshold to the least loaded eligible members.
+// With DryRun the moves are computed the same way and then rolled back.
+func (s *Service) RebalanceTeam(ctx context.Context, input RebalanceInput) (RebalanceResult, error) {
+	result := RebalanceResult{TeamName: input.TeamName, Threshold: defaultRebalanceThreshold, DryRun: input.DryRun}
+	if input.TeamName == "" {
+		return RebalanceResult{}, domain.ErrInvalidInput
+	}
+	if input.Threshold != nil {
+		if *input.Threshold < 0 {
+			return RebalanceResult{}, domain.ErrInvalidInput
+		}
+		result.Threshold = *input.Threshold
+	}
+
+	run := s.withTx
+	if input.DryRun {
+		run = s.withRollback
+	}
+	err := run(ctx, func(tx pgx.Tx) error {
+		var exists bool
+		if err := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE name = $1)`, input.TeamName).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return domain.ErrTeamNotFound
+		}
+
+		members, err := s.loadCandidates(ctx, tx, input.TeamName)
+		if err != nil {
+			return err
+		}
+		if len(members) == 0 {
+			return nil
+		}
+		total := 0
+		for _, m := range members {
+			total += m.OpenReviews
+		}
+		result.Average = float64(total) / float64(len(members))
+		limit := result.Average + float64(result.Threshold)
+
+		for {
+			change, err := s.rebalanceStep(ctx, tx, members, limit)
+			if err != nil {
+				return err
+			}
+			if change == nil {
+				return nil
+			}
+			result.Reassignments = append(result.Reassignments, *change)
+		}
+	})
+	if err != nil {
+		return RebalanceResult{}, err
+	}
+	return result, nil
+}
+
+// rebalanceStep performs a single move off the most loaded member above
+// limit and updates members in place. It returns nil when nothing can move.
+func (s *Service) rebalanceStep(ctx context.Context, tx pgx.Tx, members []Candidate, limit float64) (*ReassignmentChange, error) {
+	sort.SliceStable(members, func(i, j int) bool {
+		if members[i].OpenReviews != members[j].OpenReviews {
+			return members[i].OpenReviews > members[j].OpenReviews
+		}
+		return members[i].UserID < members[j].UserID
+	})
+
+	for i := range members {
+		from := &members[i]
+		if float64(from.OpenReviews) <= limit {
+			break
+		}
+
+		prIDs, err := s.listMovableReviews(ctx, tx, from.UserID)
+		if err != nil {
+			return nil, err
+		}
+		for _, prID := range prIDs {
+			pr, err := s.GetPullRequest(ctx, tx, prID)
+			if err != nil {
+				return nil, err
+			}
+			seniorOnly, err := s.seniorRequired(ctx, tx, prID, from.UserID)
+			if err != nil {
+				return nil, err
+			}
+
+			declined, err := s.listDeclinedReviewers(ctx, tx, prID)
+			if err != nil {
+				return nil, err
+			}
+			excluded := map[string]struct{}{pr.AuthorID: {}}
+			for _, id := range append(pr.AssignedReviewers, declined...) {
+				excluded[id] = struct{}{}
+			}
+			mustOwn, err := s.ownershipToKeep(ctx, tx, pr, from.UserID)
+			if err != nil {
+				return nil, err
+			}
+			to := rebalanceTarget(members, from.OpenReviews, excluded, seniorOnly, mustOwn)
+			if to == nil {
+				continue
+			}
+
+			if err := s.replaceReviewer(ctx, tx, prID, from.UserID, pickedReviewer{UserID: to.UserID}); err != nil {
+				return nil, err
+			}
+			from.OpenReviews--
+			to.OpenReviews++
+			newReviewer := to.UserID
+			return &ReassignmentChange{
+				PullRequestID: prID,
+				OldReviewerID: from.UserID,
+				NewReviewerID: &newReviewer,
+			}, nil
+		}
+	}
+	return nil, nil
+}
+
+// ownershipToKeep returns the owner lists of the paths whose only owner among
+// the reviewers is the one giving the review away.
+func (s *Service) ownershipToKeep(ctx context.Context, q dbExecutor, pr domain.PullRequest, leaving string) ([][]string, error) {
+	paths, err := s.listPullRequestFiles(ctx, q, pr.ID)
+	if err != nil {
+		return nil, err
+	}
+	owners, err := s.resolvePathOwners(ctx, q, paths)
+	if err != nil {
+		return nil, err
+	}
+	remaining := make(map[string]struct{}, len(pr.AssignedReviewers))
+	for _, id := range pr.AssignedReviewers {
+		if id != leaving {
+			remaining[id] = struct{}{}
+		}
+	}
+	return ownedOnlyBy(owners, remaining, leaving), nil
+}
+
+// rebalanceTarget picks the least loaded member that would end up strictly
+// less loaded than the reviewer giving the review away. The member must own
+// every path listed in mustOwn so that ownership coverage is kept.
+func rebalanceTarget(members []Candidate, fromLoad int, excluded map[string]struct{}, seniorOnly bool, mustOwn [][]string) *Candidate {
+	var target *Candidate
+	for i := range members {
+		c := &members[i]
+		if _, skip := excluded[c.UserID]; skip {
+			continue
+		}
+		if c.Weight == 0 || c.AtCapacity() || c.OpenReviews+1 >= fromLoad {
+			continue
+		}
+		if seniorOnly && !c.Senior() {
+			continue
+		}
+		if !ownsAll(c.UserID, mustOwn) {
+			continue
+		}
+		if target == nil || c.OpenReviews < target.OpenReviews ||
+			(c.OpenReviews == target.OpenReviews && c.UserID < target.UserID) {
+			target = c
+		}
+	}
+	return target
+}
+
+// listMovableReviews lists the user's OPEN reviews that are not pinned and
+// still wait for the user's decision.
+func (s *Service) listMovableReviews(ctx context.Context, q dbExecutor, userID string) ([]string, error) {
+	rows, err := q.Query(ctx, `
+        SELECT pr.id
+        FROM pull_requests pr
+        JOIN pull_request_reviewers r ON r.pull_request_id = pr.id
+        WHERE r.reviewer_id = $1 AND pr.status = 'OPEN' AND r.is_pinned = false AND r.state = 'PENDING'
+        ORDER BY pr.created_at DESC, pr.id
+    `, userID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var prIDs []string
+	for rows.Next() {
+		var prID string
+		if err := rows.Scan(&prID); err != nil {
+			return nil, err
+		}
+		prIDs = append(prIDs, prID)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return prIDs, nil
+}
+
+func ownsAll(userID string, mustOwn [][]string) bool {
+	for _, pathOwners := range mustOwn {
+		if !isCovered(pathOwners, map[string]struct{}{userID: {}}) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+func (s *Service) AddRepository(ctx context.Context, repo domain.Repository) (domain.Repository, error) {
+	repo.Name = strings.TrimSpace(repo.Name)
+	if repo.Name == "" || strings.Contains(repo.Name, "#") {
+		return domain.Repository{}, domain.ErrInvalidInput
+	}
+	for _, value := range []*int{repo.ReviewerCount, repo.MinReviewers, repo.RequiredApprovals} {
+		if value != nil && *value < 0 {
+			return domain.Repository{}, domain.ErrInvalidInput
+		}
+	}
+	if repo.ReviewerCount != nil && repo.MinReviewers != nil && *repo.MinReviewers > *repo.ReviewerCount {
+		return domain.Repository{}, domain.ErrInvalidInput
+	}
+
+	if repo.DefaultTeam != "" {
+		var exists bool
+		if err := s.db.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE name = $1)`, repo.DefaultTeam).Scan(&exists); err != nil {
+			return domain.Repository{}, err
+		}
+		if !exists {
+			return domain.Repository{}, domain.ErrTeamNotFound
+		}
+	}
+
+	repo.CreatedAt = s.now()
+	_, err := s.db.Exec(ctx, `
+        INSERT INTO repositories (name, default_team, reviewer_count, min_reviewers, required_approvals, created_at)
+        VALUES ($1, NULLIF($2, ''), $3, $4, $5, $6)
+    `, repo.Name, repo.DefaultTeam, repo.ReviewerCount, repo.MinReviewers, repo.RequiredApprovals, repo.CreatedAt)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return domain.Repository{}, domain.ErrRepositoryExists
+		}
+		return domain.Repository{}, err
+	}
+	return repo, nil
+}
+
+func (s *Service) GetRepository(ctx context.Context, name string) (domain.Repository, error) {
+	return s.getRepository(ctx, s.db, name)
+}
+
+func (s *Service) ListRepositories(ctx context.Context) ([]domain.Repository, error) {
+	rows, err := s.db.Query(ctx, `
+        SELECT name, COALESCE(default_team, ''), reviewer_count, min_reviewers, required_approvals, created_at
+        FROM repositories
+        ORDER BY name
+    `)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	repos := make([]domain.Repository, 0)
+	for rows.Next() {
+		var repo domain.Repository
+		if err := rows.Scan(&repo.Name, &repo.DefaultTeam, &repo.ReviewerCount, &repo.MinReviewers,
+			&repo.RequiredApprovals, &repo.CreatedAt); err != nil {
+			return nil, err
+		}
+		repos = append(repos, repo)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return repos, nil
+}
+
+func (s *Service) getRepository(ctx context.Context, q dbExecutor, name string) (domain.Repository, error) {
+	var repo domain.Repository
+	err := q.QueryRow(ctx, `
+        SELECT name, COALESCE(default_team, ''), reviewer_count, min_reviewers, required_approvals, created_at
+        FROM repositories
+        WHERE name = $1
+    `, name).Scan(&repo.Name, &repo.DefaultTeam, &repo.ReviewerCount, &repo.MinReviewers,
+		&repo.RequiredApprovals, &repo.CreatedAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.Repository{}, domain.ErrRepositoryNotFound
+		}
+		return domain.Repository{}, err
+	}
+	return repo, nil
+}
+
+// resolvePullRequestID fills in the repository and ID of a new pull request.
+// Without a repository the pull request goes to the default one; without an
+// ID it is derived from the repository and number as "repository#number".
+// An explicit ID must match that form when a number is given. Without a
+// number it may not have the "repository#number" shape itself, so that it
+// never takes the ID a numbered pull request would get; other IDs, including
+// ones with "#", are accepted as before.
+func resolvePullRequestID(input CreatePullRequestInput) (CreatePullRequestInput, error) {
+	input.Repository = strings.TrimSpace(input.Repository)
+	if input.Repository == "" {
+		input.Repository = domain.DefaultRepository
+	}
+	if input.Number == nil {
+		if input.ID == "" || isNumberedPullRequestID(input.ID) {
+			return CreatePullRequestInput{}, domain.ErrInvalidInput
+		}
+		return input, nil
+	}
+	if *input.Number <= 0 {
+		return CreatePullRequestInput{}, domain.ErrInvalidInput
+	}
+	derived := numberedPullRequestID(input.Repository, *input.Number)
+	if input.ID != "" && input.ID != derived {
+		return CreatePullRequestInput{}, domain.ErrInvalidInput
+	}
+	input.ID = derived
+	return input, nil
+}
+
+func numberedPullRequestID(repository string, number int) string {
+	return fmt.Sprintf("%s#%d", repository, number)
+}
+
+// isNumberedPullRequestID reports whether id could have been produced by
+// numberedPullRequestID: a valid repository name, "#" and a positive number.
+func isNumberedPullRequestID(id string) bool {
+	repository, number, ok := strings.Cut(id, "#")
+	if !ok || strings.TrimSpace(repository) == "" || strings.Contains(number, "#") {
+		return false
+	}
+	n, err := strconv.Atoi(number)
+	return err == nil && n > 0 && number == strconv.Itoa(n)
+}
+
+// FetchPullRequestByNumber looks a pull request up by its repository and
+// number. An empty repository means the default one.
+func (s *Service) FetchPullRequestByNumber(ctx context.Context, repository string, number int) (domain.PullRequest, error) {
+	repository = strings.TrimSpace(repository)
+	if repository == "" {
+		repository = domain.DefaultRepository
+	}
+	var prID string
+	err := s.db.QueryRow(ctx, `
+        SELECT id FROM pull_requests WHERE repository = $1 AND number = $2
+    `, repository, number).Scan(&prID)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return domain.PullRequest{}, domain.ErrPullRequestNotFound
+		}
+		return domain.PullRequest{}, err
+	}
+	return s.GetPullRequest(ctx, s.db, prID)
+}
+
+// applyRepositorySettings overrides team settings with the ones set on the
+// repository. MinReviewers is capped at ReviewerCount so that a repository
+// lowering the reviewer count does not make every pull request fail.
+func applyRepositorySettings(settings *domain.TeamSettings, repo domain.Repository) {
+	if repo.ReviewerCount != nil {
+		settings.ReviewerCount = *repo.ReviewerCount
+	}
+	if repo.MinReviewers != nil {
+		settings.MinReviewers = *repo.MinReviewers
+	}
+	if repo.RequiredApprovals != nil {
+		settings.RequiredApprovals = *repo.RequiredApprovals
+	}
+	if settings.MinReviewers > settings.ReviewerCount {
+		settings.MinReviewers = settings.ReviewerCount
+	}
+	if settings.MinSeniorReviewers > settings.ReviewerCount {
+		settings.MinSeniorReviewers = settings.ReviewerCount
+	}
+}
@@ -0,0 +1,103 @@
+package service
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+func TestResolvePullRequestID(t *testing.T) {
+	number := func(n int) *int { return &n }
+
+	tests := []struct {
+		name           string
+		input          CreatePullRequestInput
+		wantID         string
+		wantRepository string
+		wantErr        error
+	}{
+		{
+			name:           "plain id goes to the default repository",
+			input:          CreatePullRequestInput{ID: "pr-1001"},
+			wantID:         "pr-1001",
+			wantRepository: domain.DefaultRepository,
+		},
+		{
+			name:           "id is derived from repository and number",
+			input:          CreatePullRequestInput{Repository: " backend ", Number: number(42)},
+			wantID:         "backend#42",
+			wantRepository: "backend",
+		},
+		{
+			name:           "number without repository uses the default one",
+			input:          CreatePullRequestInput{Number: number(7)},
+			wantID:         "default#7",
+			wantRepository: domain.DefaultRepository,
+		},
+		{
+			name:           "matching explicit id is accepted",
+			input:          CreatePullRequestInput{ID: "backend#42", Repository: "backend", Number: number(42)},
+			wantID:         "backend#42",
+			wantRepository: "backend",
+		},
+		{
+			name:    "explicit id must match repository and number",
+			input:   CreatePullRequestInput{ID: "pr-1001", Repository: "backend", Number: number(42)},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "id from another repository is rejected",
+			input:   CreatePullRequestInput{ID: "frontend#42", Repository: "backend", Number: number(42)},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "id without number may not look numbered",
+			input:   CreatePullRequestInput{ID: "backend#42"},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:           "other ids with # are still accepted",
+			input:          CreatePullRequestInput{ID: "fix#login"},
+			wantID:         "fix#login",
+			wantRepository: domain.DefaultRepository,
+		},
+		{
+			name:           "zero-padded number does not look numbered",
+			input:          CreatePullRequestInput{ID: "backend#042"},
+			wantID:         "backend#042",
+			wantRepository: domain.DefaultRepository,
+		},
+		{
+			name:           "id with several # cannot collide",
+			input:          CreatePullRequestInput{ID: "team#backend#42"},
+			wantID:         "team#backend#42",
+			wantRepository: domain.DefaultRepository,
+		},
+		{
+			name:    "neither id nor number",
+			input:   CreatePullRequestInput{Repository: "backend"},
+			wantErr: domain.ErrInvalidInput,
+		},
+		{
+			name:    "non-positive number",
+			input:   CreatePullRequestInput{Repository: "backend", Number: number(0)},
+			wantErr: domain.ErrInvalidInput,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := resolvePullRequestID(tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("error = %v, want %v", err, tt.wantErr)
+			}
+			if tt.wantErr != nil {
+				return
+			}
+			if got.ID != tt.wantID || got.Repository != tt.wantRepository {
+				t.Errorf("got id %q repository %q, want %q %q", got.ID, got.Repository, tt.wantID, tt.wantRepository)
+			}
+		})
+	}
+}
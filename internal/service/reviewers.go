@@ -0,0 +1,325 @@
+package service
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+// AddReviewerInput names the reviewer to add. A nil Pinned pins a newly added
+// reviewer and leaves the flag of an already assigned one as it is.
+type AddReviewerInput struct {
+	PullRequestID string
+	UserID        string
+	Pinned        *bool
+}
+
+// AddReviewer puts a specific user on an open pull request, bypassing the
+// automatic selection rules. For an already assigned reviewer it only updates
+// the pinned flag, and only when Pinned is set.
+func (s *Service) AddReviewer(ctx context.Context, input AddReviewerInput) (domain.PullRequest, error) {
+	var result domain.PullRequest
+	err := s.withTx(ctx, func(tx pgx.Tx) error {
+		pr, err := s.GetPullRequest(ctx, tx, input.PullRequestID)
+		if err != nil {
+			return err
+		}
+		if err := ensureNotFinished(pr); err != nil {
+			return err
+		}
+		if pr.Status == domain.PullRequestStatusDraft {
+			return domain.ErrPullRequestDraft
+		}
+		user, err := s.getUser(ctx, tx, input.UserID)
+		if err != nil {
+			return err
+		}
+		if user.ID == pr.AuthorID || !user.IsActive {
+			return domain.ErrInvalidInput
+		}
+
+		ct, err := tx.Exec(ctx, `
+            UPDATE pull_request_reviewers
+            SET is_pinned = COALESCE($3, is_pinned)
+            WHERE pull_request_id = $1 AND reviewer_id = $2
+        `, input.PullRequestID, input.UserID, input.Pinned)
+		if err != nil {
+			return err
+		}
+		if ct.RowsAffected() == 0 {
+			pinned := input.Pinned == nil || *input.Pinned
+			if err := s.addReviewer(ctx, tx, input.PullRequestID, pickedReviewer{UserID: input.UserID, Pinned: pinned}); err != nil {
+				return err
+			}
+		}
+		if err := s.bumpPullRequestVersion(ctx, tx, input.PullRequestID); err != nil {
+			return err
+		}
+
+		updated, err := s.GetPullRequest(ctx, tx, input.PullRequestID)
+		if err != nil {
+			return err
+		}
+		result = updated
+		return nil
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	return result, nil
+}
+
+// RemoveReviewer takes a reviewer off an open pull request without picking a
+// replacement. Pinned reviewers can be removed this way too.
+func (s *Service) RemoveReviewer(ctx context.Context, prID, userID string) (domain.PullRequest, error) {
+	var result domain.PullRequest
+	err := s.withTx(ctx, func(tx pgx.Tx) error {
+		pr, err := s.GetPullRequest(ctx, tx, prID)
+		if err != nil {
+			return err
+		}
+		if err := ensureNotFinished(pr); err != nil {
+			return err
+		}
+
+		removed, err := s.dropReviewer(ctx, tx, prID, userID)
+		if err != nil {
+			return err
+		}
+		if !removed {
+			return domain.ErrReviewerNotAssigned
+		}
+
+		updated, err := s.GetPullRequest(ctx, tx, prID)
+		if err != nil {
+			return err
+		}
+		result = updated
+		return nil
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	return result, nil
+}
+
+// dropReviewer archives a reviewer's row and deletes it, bumping the pull
+// request version. It reports whether the reviewer was assigned at all.
+func (s *Service) dropReviewer(ctx context.Context, q dbExecutor, prID, reviewerID string) (bool, error) {
+	if err := s.archiveReviews(ctx, q, prID, reviewerID); err != nil {
+		return false, err
+	}
+	ct, err := q.Exec(ctx, `
+        DELETE FROM pull_request_reviewers
+        WHERE pull_request_id = $1 AND reviewer_id = $2
+    `, prID, reviewerID)
+	if err != nil {
+		return false, err
+	}
+	if ct.RowsAffected() == 0 {
+		return false, nil
+	}
+	return true, s.bumpPullRequestVersion(ctx, q, prID)
+}
+
+func (s *Service) isPinned(ctx context.Context, q dbExecutor, prID, reviewerID string) (bool, error) {
+	var pinned bool
+	err := q.QueryRow(ctx, `
+        SELECT EXISTS(
+            SELECT 1
+            FROM pull_request_reviewers
+            WHERE pull_request_id = $1 AND reviewer_id = $2 AND is_pinned = true
+        )
+    `, prID, reviewerID).Scan(&pinned)
+	return pinned, err
+}
+
+// hasDecided reports whether the reviewer has already submitted a decision in
+// the current round. Automatic flows leave such reviewers in place so that
+// their decision keeps counting towards the merge gate.
+func (s *Service) hasDecided(ctx context.Context, q dbExecutor, prID, reviewerID string) (bool, error) {
+	var decided bool
+	err := q.QueryRow(ctx, `
+        SELECT EXISTS(
+            SELECT 1
+            FROM pull_request_reviewers
+            WHERE pull_request_id = $1 AND reviewer_id = $2 AND state <> 'PENDING'
+        )
+    `, prID, reviewerID).Scan(&decided)
+	return decided, err
+}
+
+type SubmitReviewInput struct {
+	PullRequestID string
+	ReviewerID    string
+	State         string
+}
+
+// SubmitReview records an assigned reviewer's decision. A later decision
+// replaces the earlier one.
+func (s *Service) SubmitReview(ctx context.Context, input SubmitReviewInput) (domain.PullRequest, error) {
+	switch input.State {
+	case domain.ReviewStateApproved, domain.ReviewStateChangesRequested, domain.ReviewStateCommented:
+	default:
+		return domain.PullRequest{}, domain.ErrInvalidInput
+	}
+
+	var result domain.PullRequest
+	err := s.withTx(ctx, func(tx pgx.Tx) error {
+		pr, err := s.GetPullRequest(ctx, tx, input.PullRequestID)
+		if err != nil {
+			return err
+		}
+		if err := ensureNotFinished(pr); err != nil {
+			return err
+		}
+
+		ct, err := tx.Exec(ctx, `
+            UPDATE pull_request_reviewers
+            SET state = $3,
+                decided_at = $4
+            WHERE pull_request_id = $1 AND reviewer_id = $2
+        `, input.PullRequestID, input.ReviewerID, input.State, s.now())
+		if err != nil {
+			return err
+		}
+		if ct.RowsAffected() == 0 {
+			return domain.ErrReviewerNotAssigned
+		}
+		if err := s.bumpPullRequestVersion(ctx, tx, input.PullRequestID); err != nil {
+			return err
+		}
+
+		updated, err := s.GetPullRequest(ctx, tx, input.PullRequestID)
+		if err != nil {
+			return err
+		}
+		result = updated
+		return nil
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	return result, nil
+}
+
+func (s *Service) listReviews(ctx context.Context, q dbExecutor, prID string) ([]domain.Review, error) {
+	rows, err := q.Query(ctx, `
+        SELECT reviewer_id, round, state, assigned_at, decided_at
+        FROM pull_request_reviewers
+        WHERE pull_request_id = $1
+        ORDER BY reviewer_id
+    `, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	reviews := make([]domain.Review, 0)
+	for rows.Next() {
+		var review domain.Review
+		if err := rows.Scan(&review.ReviewerID, &review.Round, &review.State, &review.AssignedAt, &review.DecidedAt); err != nil {
+			return nil, err
+		}
+		reviews = append(reviews, review)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return reviews, nil
+}
+
+// RerequestReview starts a new review round: the finished round is copied to
+// the history and every assigned reviewer goes back to PENDING.
+func (s *Service) RerequestReview(ctx context.Context, prID string) (domain.PullRequest, error) {
+	var result domain.PullRequest
+	err := s.withTx(ctx, func(tx pgx.Tx) error {
+		pr, err := s.GetPullRequest(ctx, tx, prID)
+		if err != nil {
+			return err
+		}
+		if err := ensureNotFinished(pr); err != nil {
+			return err
+		}
+		if pr.Status == domain.PullRequestStatusDraft {
+			return domain.ErrPullRequestDraft
+		}
+		if len(pr.AssignedReviewers) == 0 {
+			return domain.ErrReviewerNotAssigned
+		}
+
+		if err := s.archiveReviews(ctx, tx, prID, ""); err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `UPDATE pull_requests SET review_round = review_round + 1, version = version + 1 WHERE id = $1`, prID)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(ctx, `
+            UPDATE pull_request_reviewers
+            SET state = 'PENDING',
+                decided_at = NULL,
+                round = $2
+            WHERE pull_request_id = $1
+        `, prID, pr.ReviewRound+1)
+		if err != nil {
+			return err
+		}
+
+		result, err = s.GetPullRequest(ctx, tx, prID)
+		return err
+	})
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	return result, nil
+}
+
+// archiveReviews copies reviewer rows into review_rounds before they are
+// reset, replaced or deleted, so every round keeps the decisions made in it.
+// The history is append-only: a reviewer archived twice in one round (removed
+// and added back, or a PR closed and reopened) keeps both entries.
+// An empty reviewerID archives all reviewers of the pull request.
+func (s *Service) archiveReviews(ctx context.Context, q dbExecutor, prID, reviewerID string) error {
+	_, err := q.Exec(ctx, `
+        INSERT INTO review_rounds (pull_request_id, round, reviewer_id, state, assigned_at, decided_at, archived_at)
+        SELECT pull_request_id, round, reviewer_id, state, assigned_at, decided_at, $3
+        FROM pull_request_reviewers
+        WHERE pull_request_id = $1 AND ($2::text = '' OR reviewer_id = $2)
+    `, prID, reviewerID, s.now())
+	return err
+}
+
+// listReviewHistory returns the archived reviews grouped by round, oldest
+// first: earlier rounds plus reviewers that left the current one.
+func (s *Service) listReviewHistory(ctx context.Context, q dbExecutor, prID string) ([]domain.ReviewRound, error) {
+	rows, err := q.Query(ctx, `
+        SELECT round, reviewer_id, state, assigned_at, decided_at
+        FROM review_rounds
+        WHERE pull_request_id = $1
+        ORDER BY round, id
+    `, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	history := make([]domain.ReviewRound, 0)
+	for rows.Next() {
+		var review domain.Review
+		if err := rows.Scan(&review.Round, &review.ReviewerID, &review.State, &review.AssignedAt, &review.DecidedAt); err != nil {
+			return nil, err
+		}
+		if len(history) == 0 || history[len(history)-1].Round != review.Round {
+			history = append(history, domain.ReviewRound{Round: review.Round})
+		}
+		last := &history[len(history)-1]
+		last.Reviews = append(last.Reviews, review)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return history, nil
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// recordingDB is a dbExecutor that records statements instead of running
+// them. QueryRow answers with the values queued in rows, in order.
+type recordingDB struct {
+	execs []recordedExec
+	rows  [][]any
+}
+
+type recordedExec struct {
+	sql  string
+	args []any
+}
+
+func (db *recordingDB) Exec(_ context.Context, sql string, args ...any) (pgconn.CommandTag, error) {
+	db.execs = append(db.execs, recordedExec{sql: sql, args: args})
+	return pgconn.NewCommandTag("UPDATE 1"), nil
+}
+
+func (db *recordingDB) Query(context.Context, string, ...any) (pgx.Rows, error) {
+	return nil, errors.New("recordingDB: Query is not supported")
+}
+
+func (db *recordingDB) QueryRow(context.Context, string, ...any) pgx.Row {
+	if len(db.rows) == 0 {
+		return recordedRow{err: pgx.ErrNoRows}
+	}
+	values := db.rows[0]
+	db.rows = db.rows[1:]
+	return recordedRow{values: values}
+}
+
+// find returns the first recorded statement containing fragment.
+func (db *recordingDB) find(t *testing.T, fragment string) recordedExec {
+	t.Helper()
+	for _, exec := range db.execs {
+		if strings.Contains(exec.sql, fragment) {
+			return exec
+		}
+	}
+	t.Fatalf("no statement containing %q was executed", fragment)
+	return recordedExec{}
+}
+
+type recordedRow struct {
+	values []any
+	err    error
+}
+
+func (r recordedRow) Scan(dest ...any) error {
+	if r.err != nil {
+		return r.err
+	}
+	if len(dest) != len(r.values) {
+		return fmt.Errorf("recordedRow: scan into %d values, have %d", len(dest), len(r.values))
+	}
+	for i, value := range r.values {
+		switch d := dest[i].(type) {
+		case *bool:
+			*d = value.(bool)
+		case *string:
+			*d = value.(string)
+		default:
+			return fmt.Errorf("recordedRow: unsupported destination %T", dest[i])
+		}
+	}
+	return nil
+}
+
+func TestReplaceReviewerDoesNotInheritPin(t *testing.T) {
+	tests := []struct {
+		name       string
+		reviewer   pickedReviewer
+		wantPinned bool
+	}{
+		{
+			// DeclineReview hands the seat of a pinned reviewer who declined
+			// to an automatic pick from pickReplacement, which is never pinned.
+			name:       "pinned reviewer declines",
+			reviewer:   pickedReviewer{UserID: "u3"},
+			wantPinned: false,
+		},
+		{
+			name:       "explicitly pinned replacement",
+			reviewer:   pickedReviewer{UserID: "u3", Pinned: true},
+			wantPinned: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &recordingDB{rows: [][]any{{false}}}
+			s := &Service{now: testNow}
+
+			if err := s.replaceReviewer(context.Background(), db, "pr-1", "u2", tt.reviewer); err != nil {
+				t.Fatalf("replaceReviewer: %v", err)
+			}
+
+			update := db.find(t, "UPDATE pull_request_reviewers")
+			if !strings.Contains(update.sql, "is_pinned = $6") {
+				t.Fatalf("replacement does not reset is_pinned:\n%s", update.sql)
+			}
+			if got := update.args[5]; got != tt.wantPinned {
+				t.Errorf("is_pinned = %v, want %v", got, tt.wantPinned)
+			}
+			if update.args[1] != "u2" || update.args[2] != tt.reviewer.UserID {
+				t.Errorf("replaced %v with %v, want u2 with %s", update.args[1], update.args[2], tt.reviewer.UserID)
+			}
+		})
+	}
+}
+
+func TestReplaceReviewerRecomputesFallback(t *testing.T) {
+	tests := []struct {
+		name         string
+		reviewer     pickedReviewer
+		isFallback   bool
+		wantFallback bool
+	}{
+		{
+			name:         "fallback reviewer replaced from the author's team",
+			reviewer:     pickedReviewer{UserID: "u3", Fallback: true},
+			isFallback:   false,
+			wantFallback: false,
+		},
+		{
+			name:         "team reviewer replaced from a fallback team",
+			reviewer:     pickedReviewer{UserID: "u7"},
+			isFallback:   true,
+			wantFallback: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := &recordingDB{rows: [][]any{{tt.isFallback}}}
+			s := &Service{now: testNow}
+
+			if err := s.replaceReviewer(context.Background(), db, "pr-1", "u2", tt.reviewer); err != nil {
+				t.Fatalf("replaceReviewer: %v", err)
+			}
+
+			update := db.find(t, "UPDATE pull_request_reviewers")
+			if !strings.Contains(update.sql, "is_fallback = $4,") {
+				t.Fatalf("replacement keeps the old fallback flag:\n%s", update.sql)
+			}
+			if got := update.args[3]; got != tt.wantFallback {
+				t.Errorf("is_fallback = %v, want %v", got, tt.wantFallback)
+			}
+		})
+	}
+}
+
+func testNow() time.Time {
+	return time.Date(2025, time.March, 10, 12, 0, 0, 0, time.UTC)
+}
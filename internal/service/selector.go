@@ -0,0 +1,177 @@
+package service
+
+import (
+	"math/rand"
+	"sort"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+const (
+	DefaultReviewerStrategy = "random"
+	LeastLoadedStrategy     = "least_loaded"
+)
+
+type Candidate struct {
+	UserID         string
+	TeamName       string
+	Role           string
+	OpenReviews    int
+	MaxOpenReviews *int
+	Weight         int
+	Skills         []string
+	RecentPairings int
+	Available      bool
+}
+
+func (c Candidate) AtCapacity() bool {
+	return c.MaxOpenReviews != nil && c.OpenReviews >= *c.MaxOpenReviews
+}
+
+func (c Candidate) Senior() bool {
+	return c.Role == domain.RoleSenior || c.Role == domain.RoleLead
+}
+
+type SelectionRequest struct {
+	TeamName   string
+	AuthorID   string
+	Limit      int
+	Labels     []string
+	Candidates []Candidate
+}
+
+type ReviewerSelector interface {
+	Select(r *rand.Rand, req SelectionRequest) []string
+}
+
+type RandomSelector struct{}
+
+func (RandomSelector) Select(r *rand.Rand, req SelectionRequest) []string {
+	if len(req.Candidates) <= req.Limit {
+		return candidateIDs(req.Candidates)
+	}
+	return candidateIDs(weightedShuffle(r, req.Candidates)[:req.Limit])
+}
+
+type LeastLoadedSelector struct{}
+
+func (LeastLoadedSelector) Select(r *rand.Rand, req SelectionRequest) []string {
+	ranked := weightedShuffle(r, req.Candidates)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].OpenReviews < ranked[j].OpenReviews
+	})
+
+	if len(ranked) > req.Limit {
+		ranked = ranked[:req.Limit]
+	}
+	return candidateIDs(ranked)
+}
+
+// weightedShuffle returns a copy of candidates in random order where each
+// next position is drawn with probability proportional to the sampling weight:
+// Weight divided by one plus the recent pairings with the author, so frequent
+// pairs stay eligible but are drawn less often. Candidates with a non-positive
+// weight keep their order at the end.
+func weightedShuffle(r *rand.Rand, candidates []Candidate) []Candidate {
+	pool := make([]Candidate, 0, len(candidates))
+	var rest []Candidate
+	total := 0.0
+	for _, c := range candidates {
+		if c.Weight <= 0 {
+			rest = append(rest, c)
+			continue
+		}
+		pool = append(pool, c)
+		total += samplingWeight(c)
+	}
+
+	result := make([]Candidate, 0, len(candidates))
+	for len(pool) > 0 {
+		n := r.Float64() * total
+		i := 0
+		for i < len(pool)-1 && n >= samplingWeight(pool[i]) {
+			n -= samplingWeight(pool[i])
+			i++
+		}
+		result = append(result, pool[i])
+		total -= samplingWeight(pool[i])
+		pool = append(pool[:i], pool[i+1:]...)
+	}
+	return append(result, rest...)
+}
+
+func samplingWeight(c Candidate) float64 {
+	return float64(c.Weight) / float64(1+c.RecentPairings)
+}
+
+// selectPreferred hands candidates to the selector in preference tiers:
+// reviewers inside their working hours first, then skill matches with the PR
+// labels. Later tiers only fill slots the earlier ones left empty. Recent
+// pairings are not a tier: they only lower the sampling weight, so the team
+// strategy still decides within a tier. Candidates with zero weight are never
+// auto-assigned.
+func selectPreferred(selector ReviewerSelector, r *rand.Rand, req SelectionRequest) []string {
+	tiers := make(map[[2]int][]Candidate)
+	for _, c := range req.Candidates {
+		if c.Weight == 0 {
+			continue
+		}
+		rank := preferenceRank(c, req.Labels)
+		tiers[rank] = append(tiers[rank], c)
+	}
+	ranks := make([][2]int, 0, len(tiers))
+	for rank := range tiers {
+		ranks = append(ranks, rank)
+	}
+	sort.Slice(ranks, func(i, j int) bool {
+		for k := range ranks[i] {
+			if ranks[i][k] != ranks[j][k] {
+				return ranks[i][k] < ranks[j][k]
+			}
+		}
+		return false
+	})
+
+	var picked []string
+	for _, rank := range ranks {
+		if len(picked) >= req.Limit {
+			break
+		}
+		tier := req
+		tier.Limit = req.Limit - len(picked)
+		tier.Candidates = tiers[rank]
+		picked = append(picked, selector.Select(r, tier)...)
+	}
+	return picked
+}
+
+func preferenceRank(c Candidate, labels []string) [2]int {
+	availabilityRank := 0
+	if !c.Available {
+		availabilityRank = 1
+	}
+	skillRank := 0
+	if len(labels) > 0 && !matchesLabels(c.Skills, labels) {
+		skillRank = 1
+	}
+	return [2]int{availabilityRank, skillRank}
+}
+
+func matchesLabels(skills, labels []string) bool {
+	for _, skill := range skills {
+		for _, label := range labels {
+			if skill == label {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func candidateIDs(candidates []Candidate) []string {
+	ids := make([]string, 0, len(candidates))
+	for _, c := range candidates {
+		ids = append(ids, c.UserID)
+	}
+	return ids
+}
@@ -0,0 +1,182 @@
+package service
+
+import (
+	"math/rand"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestWeightedShuffle(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []Candidate
+		wantTail   []string
+	}{
+		{
+			name:       "empty",
+			candidates: nil,
+		},
+		{
+			name: "non-positive weights keep their order at the end",
+			candidates: []Candidate{
+				{UserID: "u1", Weight: 0},
+				{UserID: "u2", Weight: 2},
+				{UserID: "u3", Weight: -1},
+				{UserID: "u4", Weight: 1},
+			},
+			wantTail: []string{"u1", "u3"},
+		},
+		{
+			name: "pairings do not drop a candidate",
+			candidates: []Candidate{
+				{UserID: "u1", Weight: 1, RecentPairings: 100},
+				{UserID: "u2", Weight: 1},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			for i := 0; i < 50; i++ {
+				got := candidateIDs(weightedShuffle(r, tt.candidates))
+				want := candidateIDs(tt.candidates)
+				sortedGot := append(make([]string, 0, len(got)), got...)
+				sort.Strings(sortedGot)
+				sort.Strings(want)
+				if !reflect.DeepEqual(sortedGot, want) {
+					t.Fatalf("shuffle = %v, not a permutation of %v", got, want)
+				}
+				if tail := got[len(got)-len(tt.wantTail):]; len(tt.wantTail) > 0 && !reflect.DeepEqual(tail, tt.wantTail) {
+					t.Fatalf("tail = %v, want %v", tail, tt.wantTail)
+				}
+			}
+		})
+	}
+}
+
+func TestWeightedShuffleDistribution(t *testing.T) {
+	tests := []struct {
+		name       string
+		candidates []Candidate
+		minShare   float64
+		maxShare   float64
+	}{
+		{
+			name:       "weight is proportional",
+			candidates: []Candidate{{UserID: "heavy", Weight: 3}, {UserID: "light", Weight: 1}},
+			minShare:   0.70,
+			maxShare:   0.80,
+		},
+		{
+			name:       "recent pairings lower the weight",
+			candidates: []Candidate{{UserID: "heavy", Weight: 1}, {UserID: "light", Weight: 1, RecentPairings: 2}},
+			minShare:   0.70,
+			maxShare:   0.80,
+		},
+		{
+			name:       "pairings and weight combine",
+			candidates: []Candidate{{UserID: "heavy", Weight: 2, RecentPairings: 1}, {UserID: "light", Weight: 1}},
+			minShare:   0.45,
+			maxShare:   0.55,
+		},
+	}
+
+	const trials = 20000
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(42))
+			first := 0
+			for i := 0; i < trials; i++ {
+				if weightedShuffle(r, tt.candidates)[0].UserID == "heavy" {
+					first++
+				}
+			}
+			share := float64(first) / trials
+			if share < tt.minShare || share > tt.maxShare {
+				t.Errorf("heavy first in %.3f of draws, want between %.2f and %.2f", share, tt.minShare, tt.maxShare)
+			}
+		})
+	}
+}
+
+func TestSelectPreferred(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector ReviewerSelector
+		req      SelectionRequest
+		want     []string
+	}{
+		{
+			name:     "zero weight is never picked",
+			selector: RandomSelector{},
+			req: SelectionRequest{
+				Limit: 2,
+				Candidates: []Candidate{
+					{UserID: "u1", Weight: 0, Available: true},
+					{UserID: "u2", Weight: 1, Available: true},
+				},
+			},
+			want: []string{"u2"},
+		},
+		{
+			name:     "available reviewers come first",
+			selector: RandomSelector{},
+			req: SelectionRequest{
+				Limit: 1,
+				Candidates: []Candidate{
+					{UserID: "away", Weight: 1},
+					{UserID: "here", Weight: 1, Available: true},
+				},
+			},
+			want: []string{"here"},
+		},
+		{
+			name:     "skill matches come before other available reviewers",
+			selector: RandomSelector{},
+			req: SelectionRequest{
+				Limit:  1,
+				Labels: []string{"go"},
+				Candidates: []Candidate{
+					{UserID: "sql", Weight: 1, Available: true, Skills: []string{"sql"}},
+					{UserID: "gopher", Weight: 1, Available: true, Skills: []string{"go"}},
+				},
+			},
+			want: []string{"gopher"},
+		},
+		{
+			name:     "later tiers fill the remaining slots",
+			selector: RandomSelector{},
+			req: SelectionRequest{
+				Limit: 2,
+				Candidates: []Candidate{
+					{UserID: "away", Weight: 1},
+					{UserID: "here", Weight: 1, Available: true},
+				},
+			},
+			want: []string{"here", "away"},
+		},
+		{
+			name:     "least loaded wins over recent pairings",
+			selector: LeastLoadedSelector{},
+			req: SelectionRequest{
+				Limit: 1,
+				Candidates: []Candidate{
+					{UserID: "busy", Weight: 1, Available: true, OpenReviews: 5},
+					{UserID: "idle", Weight: 1, Available: true, OpenReviews: 0, RecentPairings: 3},
+				},
+			},
+			want: []string{"idle"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := rand.New(rand.NewSource(1))
+			if got := selectPreferred(tt.selector, r, tt.req); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("selectPreferred = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,7 +3,11 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
+	"hash/fnv"
 	"math/rand"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -14,17 +18,108 @@ import (
 )
 
 type Service struct {
-	db *pgxpool.Pool
+	db                *pgxpool.Pool
+	selectors         map[string]ReviewerSelector
+	newSource         func() rand.Source
+	now               func() time.Time
+	seedByPullRequest bool
+	availableWithin   time.Duration
 }
 
-func New(db *pgxpool.Pool) *Service {
-	return &Service{db: db}
+type Option func(*Service)
+
+func WithSelector(name string, selector ReviewerSelector) Option {
+	return func(s *Service) {
+		s.selectors[name] = selector
+	}
+}
+
+func WithRandSource(newSource func() rand.Source) Option {
+	return func(s *Service) {
+		s.newSource = newSource
+	}
+}
+
+func WithClock(now func() time.Time) Option {
+	return func(s *Service) {
+		s.now = now
+	}
+}
+
+// WithPullRequestSeed seeds every selection from the pull request ID (and the
+// replaced reviewer, if any), so the same input always yields the same reviewers.
+func WithPullRequestSeed() Option {
+	return func(s *Service) {
+		s.seedByPullRequest = true
+	}
+}
+
+// WithAvailabilityWindow also treats reviewers whose working hours begin
+// within d from now as available.
+func WithAvailabilityWindow(d time.Duration) Option {
+	return func(s *Service) {
+		s.availableWithin = d
+	}
+}
+
+func New(db *pgxpool.Pool, opts ...Option) *Service {
+	s := &Service{
+		db: db,
+		selectors: map[string]ReviewerSelector{
+			DefaultReviewerStrategy: RandomSelector{},
+			LeastLoadedStrategy:     LeastLoadedSelector{},
+		},
+		newSource: func() rand.Source {
+			return rand.NewSource(time.Now().UnixNano())
+		},
+		now: time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
 type CreatePullRequestInput struct {
-	ID       string
-	Name     string
-	AuthorID string
+	ID           string
+	Name         string
+	AuthorID     string
+	ChangedFiles []string
+	Labels       []string
+	Description  string
+	Repository   string
+	Number       *int
+	Size         *domain.DiffSize
+	Draft        bool
+}
+
+type CreatePullRequestResult struct {
+	PullRequest domain.PullRequest
+	Warnings    []string
+}
+
+type reviewerRequest struct {
+	PullRequestID string
+	TeamName      string
+	AuthorID      string
+	Limit         int
+	Paths         []string
+	Labels        []string
+	Pairings      map[string]int
+	MinSeniors    int
+}
+
+type pickedReviewer struct {
+	UserID   string
+	Fallback bool
+	Pinned   bool
+}
+
+type reviewerPick struct {
+	Reviewers      []pickedReviewer
+	Uncovered      []string
+	AtCapacity     []string
+	MissingSeniors int
 }
 
 type ReassignInput struct {
@@ -41,6 +136,7 @@ type ReassignmentChange struct {
 	PullRequestID string
 	OldReviewerID string
 	NewReviewerID *string
+	Failure       error
 }
 
 type BulkDeactivateResult struct {
@@ -55,6 +151,19 @@ type dbExecutor interface {
 	QueryRow(context.Context, string, ...any) pgx.Row
 }
 
+func (s *Service) randFor(keys ...string) *rand.Rand {
+	if !s.seedByPullRequest {
+		return rand.New(s.newSource())
+	}
+
+	h := fnv.New64a()
+	for _, key := range keys {
+		h.Write([]byte(key))
+		h.Write([]byte{0})
+	}
+	return rand.New(rand.NewSource(int64(h.Sum64())))
+}
+
 func (s *Service) withTx(ctx context.Context, fn func(pgx.Tx) error) error {
 	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
@@ -69,6 +178,16 @@ func (s *Service) withTx(ctx context.Context, fn func(pgx.Tx) error) error {
 	return tx.Commit(ctx)
 }
 
+func (s *Service) withRollback(ctx context.Context, fn func(pgx.Tx) error) error {
+	tx, err := s.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	return fn(tx)
+}
+
 func isUniqueViolation(err error) bool {
 	var pgErr *pgconn.PgError
 	if errors.As(err, &pgErr) {
@@ -78,8 +197,15 @@ func isUniqueViolation(err error) bool {
 }
 
 func (s *Service) CreateTeam(ctx context.Context, team domain.Team) (domain.Team, error) {
+	if team.ReviewerStrategy == "" {
+		team.ReviewerStrategy = DefaultReviewerStrategy
+	}
+	if _, ok := s.selectors[team.ReviewerStrategy]; !ok {
+		return domain.Team{}, domain.ErrUnknownStrategy
+	}
+
 	err := s.withTx(ctx, func(tx pgx.Tx) error {
-		if _, err := tx.Exec(ctx, `INSERT INTO teams (name) VALUES ($1)`, team.Name); err != nil {
+		if _, err := tx.Exec(ctx, `INSERT INTO teams (name, reviewer_strategy) VALUES ($1, $2)`, team.Name, team.ReviewerStrategy); err != nil {
 			if isUniqueViolation(err) {
 				return domain.ErrTeamExists
 			}
@@ -90,17 +216,31 @@ func (s *Service) CreateTeam(ctx context.Context, team domain.Team) (domain.Team
 			if member.UserID == "" {
 				continue
 			}
+			if member.ReviewWeight != nil && *member.ReviewWeight < 0 {
+				return domain.ErrInvalidInput
+			}
+			if member.Role != "" && !validRole(member.Role) {
+				return domain.ErrInvalidInput
+			}
 			_, err := tx.Exec(ctx, `
-                INSERT INTO users (id, username, team_name, is_active)
-                VALUES ($1, $2, $3, $4)
+                INSERT INTO users (id, username, team_name, is_active, max_open_reviews, review_weight, role)
+                VALUES ($1, $2, $3, $4, $5, COALESCE($6::integer, 1), COALESCE(NULLIF($7, ''), 'middle'))
                 ON CONFLICT (id) DO UPDATE
                 SET username = EXCLUDED.username,
                     team_name = EXCLUDED.team_name,
-                    is_active = EXCLUDED.is_active
-            `, member.UserID, member.Username, team.Name, member.IsActive)
+                    is_active = EXCLUDED.is_active,
+                    max_open_reviews = COALESCE(EXCLUDED.max_open_reviews, users.max_open_reviews),
+                    review_weight = COALESCE($6::integer, users.review_weight),
+                    role = COALESCE(NULLIF($7, ''), users.role)
+            `, member.UserID, member.Username, team.Name, member.IsActive, member.MaxOpenReviews, member.ReviewWeight, member.Role)
 			if err != nil {
 				return err
 			}
+			if member.Skills != nil {
+				if err := s.replaceUserSkills(ctx, tx, member.UserID, member.Skills); err != nil {
+					return err
+				}
+			}
 		}
 		return nil
 	})
@@ -111,8 +251,8 @@ func (s *Service) CreateTeam(ctx context.Context, team domain.Team) (domain.Team
 }
 
 func (s *Service) GetTeam(ctx context.Context, teamName string) (domain.Team, error) {
-	var name string
-	err := s.db.QueryRow(ctx, `SELECT name FROM teams WHERE name = $1`, teamName).Scan(&name)
+	var name, strategy string
+	err := s.db.QueryRow(ctx, `SELECT name, reviewer_strategy FROM teams WHERE name = $1`, teamName).Scan(&name, &strategy)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.Team{}, domain.ErrTeamNotFound
@@ -121,10 +261,11 @@ func (s *Service) GetTeam(ctx context.Context, teamName string) (domain.Team, er
 	}
 
 	rows, err := s.db.Query(ctx, `
-        SELECT id, username, is_active
-        FROM users
-        WHERE team_name = $1
-        ORDER BY username
+        SELECT u.id, u.username, u.is_active, u.role, u.max_open_reviews, u.review_weight,
+               COALESCE((SELECT array_agg(skill ORDER BY skill) FROM user_skills WHERE user_id = u.id), '{}')
+        FROM users u
+        WHERE u.team_name = $1
+        ORDER BY u.username
     `, teamName)
 	if err != nil {
 		return domain.Team{}, err
@@ -134,7 +275,7 @@ func (s *Service) GetTeam(ctx context.Context, teamName string) (domain.Team, er
 	members := make([]domain.TeamMember, 0)
 	for rows.Next() {
 		var member domain.TeamMember
-		if err := rows.Scan(&member.UserID, &member.Username, &member.IsActive); err != nil {
+		if err := rows.Scan(&member.UserID, &member.Username, &member.IsActive, &member.Role, &member.MaxOpenReviews, &member.ReviewWeight, &member.Skills); err != nil {
 			return domain.Team{}, err
 		}
 		members = append(members, member)
@@ -143,21 +284,117 @@ func (s *Service) GetTeam(ctx context.Context, teamName string) (domain.Team, er
 		return domain.Team{}, rows.Err()
 	}
 
-	return domain.Team{Name: name, Members: members}, nil
+	return domain.Team{Name: name, ReviewerStrategy: strategy, Members: members}, nil
+}
+
+func (s *Service) SetTeamReviewerStrategy(ctx context.Context, teamName, strategy string) (domain.Team, error) {
+	if _, ok := s.selectors[strategy]; !ok {
+		return domain.Team{}, domain.ErrUnknownStrategy
+	}
+
+	ct, err := s.db.Exec(ctx, `
+        UPDATE teams
+        SET reviewer_strategy = $2
+        WHERE name = $1
+    `, teamName, strategy)
+	if err != nil {
+		return domain.Team{}, err
+	}
+	if ct.RowsAffected() == 0 {
+		return domain.Team{}, domain.ErrTeamNotFound
+	}
+	return s.GetTeam(ctx, teamName)
 }
 
 func (s *Service) SetUserActive(ctx context.Context, userID string, active bool) (domain.User, error) {
-	var user domain.User
-	err := s.db.QueryRow(ctx, `
+	ct, err := s.db.Exec(ctx, `
         UPDATE users
         SET is_active = $2
         WHERE id = $1
-        RETURNING id, username, team_name, is_active
-    `, userID, active).Scan(&user.ID, &user.Username, &user.TeamName, &user.IsActive)
+    `, userID, active)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return domain.User{}, domain.ErrUserNotFound
+		return domain.User{}, err
+	}
+	if ct.RowsAffected() == 0 {
+		return domain.User{}, domain.ErrUserNotFound
+	}
+	return s.getUser(ctx, s.db, userID)
+}
+
+func (s *Service) SetUserReviewCapacity(ctx context.Context, userID string, maxOpenReviews *int) (domain.User, error) {
+	if maxOpenReviews != nil && *maxOpenReviews < 0 {
+		return domain.User{}, domain.ErrInvalidInput
+	}
+
+	ct, err := s.db.Exec(ctx, `
+        UPDATE users
+        SET max_open_reviews = $2
+        WHERE id = $1
+    `, userID, maxOpenReviews)
+	if err != nil {
+		return domain.User{}, err
+	}
+	if ct.RowsAffected() == 0 {
+		return domain.User{}, domain.ErrUserNotFound
+	}
+	return s.getUser(ctx, s.db, userID)
+}
+
+func (s *Service) SetUserReviewWeight(ctx context.Context, userID string, weight int) (domain.User, error) {
+	if weight < 0 {
+		return domain.User{}, domain.ErrInvalidInput
+	}
+
+	ct, err := s.db.Exec(ctx, `
+        UPDATE users
+        SET review_weight = $2
+        WHERE id = $1
+    `, userID, weight)
+	if err != nil {
+		return domain.User{}, err
+	}
+	if ct.RowsAffected() == 0 {
+		return domain.User{}, domain.ErrUserNotFound
+	}
+	return s.getUser(ctx, s.db, userID)
+}
+
+func (s *Service) SetUserRole(ctx context.Context, userID, role string) (domain.User, error) {
+	if !validRole(role) {
+		return domain.User{}, domain.ErrInvalidInput
+	}
+
+	ct, err := s.db.Exec(ctx, `
+        UPDATE users
+        SET role = $2
+        WHERE id = $1
+    `, userID, role)
+	if err != nil {
+		return domain.User{}, err
+	}
+	if ct.RowsAffected() == 0 {
+		return domain.User{}, domain.ErrUserNotFound
+	}
+	return s.getUser(ctx, s.db, userID)
+}
+
+func (s *Service) SetUserSkills(ctx context.Context, userID string, skills []string) (domain.User, error) {
+	var user domain.User
+	err := s.withTx(ctx, func(tx pgx.Tx) error {
+		if _, err := s.getUser(ctx, tx, userID); err != nil {
+			return err
 		}
+		if err := s.replaceUserSkills(ctx, tx, userID, skills); err != nil {
+			return err
+		}
+		updated, err := s.getUser(ctx, tx, userID)
+		if err != nil {
+			return err
+		}
+		user = updated
+		return nil
+	})
+	if err != nil {
 		return domain.User{}, err
 	}
 	return user, nil
@@ -186,8 +423,6 @@ func (s *Service) DeactivateTeamMembers(ctx context.Context, teamName string, us
 	}
 	result.DeactivatedUsers = unique
 
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-
 	err := s.withTx(ctx, func(tx pgx.Tx) error {
 		var exists bool
 		if err := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE name = $1)`, teamName).Scan(&exists); err != nil {
@@ -243,42 +478,58 @@ func (s *Service) DeactivateTeamMembers(ctx context.Context, teamName string, us
 			rows.Close()
 
 			for _, prID := range prIDs {
-				assigned, err := s.listReviewers(ctx, tx, prID)
+				pinned, err := s.isPinned(ctx, tx, prID, id)
 				if err != nil {
 					return err
 				}
-				candidates, err := s.pickReplacementCandidates(ctx, tx, teamName, assigned, id)
+				if pinned {
+					result.Reassignments = append(result.Reassignments, ReassignmentChange{
+						PullRequestID: prID,
+						OldReviewerID: id,
+						Failure:       domain.ErrReviewerPinned,
+					})
+					continue
+				}
+				decided, err := s.hasDecided(ctx, tx, prID, id)
 				if err != nil {
 					return err
 				}
+				if decided {
+					result.Reassignments = append(result.Reassignments, ReassignmentChange{
+						PullRequestID: prID,
+						OldReviewerID: id,
+						Failure:       domain.ErrReviewDecided,
+					})
+					continue
+				}
+
+				pr, err := s.GetPullRequest(ctx, tx, prID)
+				if err != nil {
+					return err
+				}
+				choice, err := s.pickReplacement(ctx, tx, prID, teamName, pr.AuthorID, pr.AssignedReviewers, id)
 				var newReviewer *string
-				if len(candidates) > 0 {
-					choice := candidates[r.Intn(len(candidates))]
-					newReviewer = &choice
-					if _, err := tx.Exec(ctx, `
-						DELETE FROM pull_request_reviewers
-						WHERE pull_request_id = $1 AND reviewer_id = $2
-					`, prID, id); err != nil {
+				var failure error
+				switch {
+				case err == nil:
+					newReviewer = &choice.UserID
+					if err := s.replaceReviewer(ctx, tx, prID, id, choice); err != nil {
 						return err
 					}
-					if _, err := tx.Exec(ctx, `
-						INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id)
-						VALUES ($1, $2)
-					`, prID, choice); err != nil {
-						return err
-					}
-				} else {
-					if _, err := tx.Exec(ctx, `
-						DELETE FROM pull_request_reviewers
-						WHERE pull_request_id = $1 AND reviewer_id = $2
-					`, prID, id); err != nil {
+				case errors.Is(err, domain.ErrNoCandidate), errors.Is(err, domain.ErrNoSeniorCandidate),
+					errors.Is(err, domain.ErrReviewersAtCapacity):
+					failure = err
+					if _, err := s.dropReviewer(ctx, tx, prID, id); err != nil {
 						return err
 					}
+				default:
+					return err
 				}
 				result.Reassignments = append(result.Reassignments, ReassignmentChange{
 					PullRequestID: prID,
 					OldReviewerID: id,
 					NewReviewerID: newReviewer,
+					Failure:       failure,
 				})
 			}
 		}
@@ -296,69 +547,211 @@ func (s *Service) DeactivateTeamMembers(ctx context.Context, teamName string, us
 	return result, nil
 }
 
-func (s *Service) CreatePullRequest(ctx context.Context, input CreatePullRequestInput) (domain.PullRequest, error) {
-	var result domain.PullRequest
-	err := s.withTx(ctx, func(tx pgx.Tx) error {
-		author, err := s.getUser(ctx, tx, input.AuthorID)
-		if err != nil {
-			return err
-		}
+func (s *Service) CreatePullRequest(ctx context.Context, input CreatePullRequestInput) (CreatePullRequestResult, error) {
+	input, err := resolvePullRequestID(input)
+	if err != nil {
+		return CreatePullRequestResult{}, err
+	}
 
-		row := tx.QueryRow(ctx, `
-            INSERT INTO pull_requests (id, name, author_id)
-            VALUES ($1, $2, $3)
-            RETURNING id, name, author_id, status, created_at, merged_at
-        `, input.ID, input.Name, input.AuthorID)
-		if err := row.Scan(&result.ID, &result.Name, &result.AuthorID, &result.Status, &result.CreatedAt, &result.MergedAt); err != nil {
-			if isUniqueViolation(err) {
-				return domain.ErrPullRequestExists
-			}
-			return err
-		}
+	var warnings []string
+	err = s.withTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		warnings, err = s.createPullRequest(ctx, tx, input)
+		return err
+	})
+	if err != nil {
+		return CreatePullRequestResult{}, err
+	}
 
-		reviewers, err := s.pickReviewers(ctx, tx, author.TeamName, input.AuthorID, 2)
+	fullPR, err := s.GetPullRequest(ctx, s.db, input.ID)
+	if err != nil {
+		return CreatePullRequestResult{}, err
+	}
+	return CreatePullRequestResult{PullRequest: fullPR, Warnings: warnings}, nil
+}
+
+// PreviewPullRequest runs CreatePullRequest in a transaction that is always
+// rolled back and returns the pull request as it would have been created.
+func (s *Service) PreviewPullRequest(ctx context.Context, input CreatePullRequestInput) (CreatePullRequestResult, error) {
+	input, err := resolvePullRequestID(input)
+	if err != nil {
+		return CreatePullRequestResult{}, err
+	}
+
+	var result CreatePullRequestResult
+	err = s.withRollback(ctx, func(tx pgx.Tx) error {
+		warnings, err := s.createPullRequest(ctx, tx, input)
 		if err != nil {
 			return err
 		}
-		result.AssignedReviewers = reviewers
-
-		for _, reviewer := range reviewers {
-			if _, err := tx.Exec(ctx, `
-                INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id)
-                VALUES ($1, $2)
-            `, result.ID, reviewer); err != nil {
-				return err
-			}
+		pr, err := s.GetPullRequest(ctx, tx, input.ID)
+		if err != nil {
+			return err
 		}
+		result = CreatePullRequestResult{PullRequest: pr, Warnings: warnings}
 		return nil
 	})
 	if err != nil {
-		return domain.PullRequest{}, err
+		return CreatePullRequestResult{}, err
 	}
+	return result, nil
+}
 
-	fullPR, err := s.GetPullRequest(ctx, s.db, result.ID)
+func (s *Service) createPullRequest(ctx context.Context, tx pgx.Tx, input CreatePullRequestInput) ([]string, error) {
+	author, err := s.getUser(ctx, tx, input.AuthorID)
 	if err != nil {
-		return domain.PullRequest{}, err
+		return nil, err
+	}
+
+	if !validDiffSize(input.Size) {
+		return nil, domain.ErrInvalidInput
+	}
+	if _, err := s.getRepository(ctx, tx, input.Repository); err != nil {
+		return nil, err
+	}
+
+	status := domain.PullRequestStatusOpen
+	if input.Draft {
+		status = domain.PullRequestStatusDraft
+	} else if err := s.checkOpenPRLimit(ctx, tx, author); err != nil {
+		return nil, err
+	}
+
+	var added, removed, files *int
+	if input.Size != nil {
+		added, removed, files = &input.Size.LinesAdded, &input.Size.LinesRemoved, &input.Size.FilesChanged
+	}
+
+	var prID string
+	err = tx.QueryRow(ctx, `
+        INSERT INTO pull_requests (id, name, author_id, status, created_at,
+                                   description, repository, number, lines_added, lines_removed, files_changed)
+        VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+        RETURNING id
+    `, input.ID, input.Name, input.AuthorID, status, s.now(),
+		input.Description, input.Repository, input.Number, added, removed, files).Scan(&prID)
+	if err != nil {
+		if isUniqueViolation(err) {
+			return nil, domain.ErrPullRequestExists
+		}
+		return nil, err
+	}
+
+	paths := normalizePaths(input.ChangedFiles)
+	if err := s.savePullRequestFiles(ctx, tx, prID, paths); err != nil {
+		return nil, err
+	}
+	labels := normalizeTags(input.Labels)
+	if err := s.replacePullRequestLabels(ctx, tx, prID, labels); err != nil {
+		return nil, err
+	}
+
+	if input.Draft {
+		return nil, nil
+	}
+	return s.assignReviewers(ctx, tx, prID, author, paths, labels)
+}
+
+// assignReviewers picks and assigns the initial reviewers of an OPEN pull
+// request and returns the warnings about reviewers that could not be found.
+// Reviewers come from the repository's default team when it has one and from
+// the author's team otherwise.
+func (s *Service) assignReviewers(ctx context.Context, tx pgx.Tx, prID string, author domain.User, paths, labels []string) ([]string, error) {
+	var warnings []string
+	_, settings, err := s.pullRequestSettings(ctx, tx, prID)
+	if err != nil {
+		return nil, err
+	}
+
+	pairings, err := s.recentPairings(ctx, tx, prID, author.ID, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	pick, err := s.pickReviewers(ctx, tx, reviewerRequest{
+		PullRequestID: prID,
+		TeamName:      settings.TeamName,
+		AuthorID:      author.ID,
+		Limit:         settings.ReviewerCount,
+		Paths:         paths,
+		Labels:        labels,
+		Pairings:      pairings,
+		MinSeniors:    settings.MinSeniorReviewers,
+	})
+	if err != nil {
+		return nil, err
 	}
-	return fullPR, nil
+	for _, path := range pick.Uncovered {
+		warnings = append(warnings, fmt.Sprintf("no available owner for path %s", path))
+	}
+	if pick.MissingSeniors > 0 {
+		if settings.MinReviewersPolicy == domain.MinReviewersPolicyReject {
+			return nil, domain.ErrNotEnoughReviewers
+		}
+		warnings = append(warnings, fmt.Sprintf("assigned %d of required %d senior reviewers",
+			settings.MinSeniorReviewers-pick.MissingSeniors, settings.MinSeniorReviewers))
+	}
+	if len(pick.Reviewers) < settings.ReviewerCount && len(pick.AtCapacity) > 0 {
+		warnings = append(warnings, fmt.Sprintf("reviewers at review capacity were skipped: %s", strings.Join(pick.AtCapacity, ", ")))
+	}
+	switch {
+	case len(pick.Reviewers) < settings.MinReviewers:
+		if settings.MinReviewersPolicy == domain.MinReviewersPolicyReject {
+			if len(pick.AtCapacity) > 0 {
+				return nil, domain.ErrReviewersAtCapacity
+			}
+			return nil, domain.ErrNotEnoughReviewers
+		}
+		warnings = append(warnings, fmt.Sprintf("assigned %d of required minimum %d reviewers", len(pick.Reviewers), settings.MinReviewers))
+	case len(pick.Reviewers) < settings.ReviewerCount:
+		warnings = append(warnings, fmt.Sprintf("only %d of %d reviewers available", len(pick.Reviewers), settings.ReviewerCount))
+	}
+	for _, reviewer := range pick.Reviewers {
+		if err := s.addReviewer(ctx, tx, prID, reviewer); err != nil {
+			return nil, err
+		}
+	}
+	return warnings, nil
 }
 
-func (s *Service) MergePullRequest(ctx context.Context, prID string) (domain.PullRequest, error) {
+// MergePullRequest merges a pull request once it has the team's required
+// approvals and no change requests. Override bypasses the check; the bypass
+// is recorded. Merging an already merged pull request is a no-op.
+func (s *Service) MergePullRequest(ctx context.Context, input MergeInput) (domain.PullRequest, error) {
+	prID := input.PullRequestID
 	err := s.withTx(ctx, func(tx pgx.Tx) error {
-		ct, err := tx.Exec(ctx, `
-			UPDATE pull_requests
-			SET status = 'MERGED',
-			    merged_at = COALESCE(merged_at, NOW())
-			WHERE id = $1
-		`, prID)
+		pr, err := s.GetPullRequest(ctx, tx, prID)
 		if err != nil {
 			return err
 		}
-		if ct.RowsAffected() == 0 {
-			return domain.ErrPullRequestNotFound
+		switch pr.Status {
+		case domain.PullRequestStatusMerged:
+			return nil
+		case domain.PullRequestStatusDraft, domain.PullRequestStatusClosed:
+			return transitionError(pr.Status, domain.PullRequestStatusMerged)
 		}
 
-		return nil
+		gate, err := s.checkMergeGate(ctx, tx, prID)
+		if err != nil {
+			return err
+		}
+		if gateErr := gate.err(); gateErr != nil {
+			if !input.Override {
+				return gateErr
+			}
+			if err := s.recordMergeOverride(ctx, tx, prID, gate, input.OverrideReason); err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Exec(ctx, `
+			UPDATE pull_requests
+			SET status = 'MERGED',
+			    merged_at = COALESCE(merged_at, $2),
+			    version = version + 1
+			WHERE id = $1
+		`, prID, s.now())
+		return err
 	})
 	if err != nil {
 		return domain.PullRequest{}, err
@@ -381,8 +774,8 @@ func (s *Service) ReassignReviewer(ctx context.Context, input ReassignInput) (Re
 			}
 			return err
 		}
-		if pr.Status == "MERGED" {
-			return domain.ErrPullRequestMerged
+		if err := ensureNotFinished(pr); err != nil {
+			return err
 		}
 
 		assigned, err := s.listReviewers(ctx, tx, input.PullRequestID)
@@ -400,6 +793,13 @@ func (s *Service) ReassignReviewer(ctx context.Context, input ReassignInput) (Re
 		if !hasOld {
 			return domain.ErrReviewerNotAssigned
 		}
+		pinned, err := s.isPinned(ctx, tx, input.PullRequestID, input.OldReviewerID)
+		if err != nil {
+			return err
+		}
+		if pinned {
+			return domain.ErrReviewerPinned
+		}
 
 		oldUser, err := s.getUser(ctx, tx, input.OldReviewerID)
 		if err != nil {
@@ -409,27 +809,12 @@ func (s *Service) ReassignReviewer(ctx context.Context, input ReassignInput) (Re
 			return err
 		}
 
-		candidates, err := s.pickReplacementCandidates(ctx, tx, oldUser.TeamName, assigned, input.OldReviewerID)
+		newReviewer, err := s.pickReplacement(ctx, tx, input.PullRequestID, oldUser.TeamName, pr.AuthorID, assigned, input.OldReviewerID)
 		if err != nil {
 			return err
 		}
-		if len(candidates) == 0 {
-			return domain.ErrNoCandidate
-		}
-
-		r := rand.New(rand.NewSource(time.Now().UnixNano()))
-		newReviewer := candidates[r.Intn(len(candidates))]
 
-		if _, err := tx.Exec(ctx, `
-            DELETE FROM pull_request_reviewers
-            WHERE pull_request_id = $1 AND reviewer_id = $2
-        `, input.PullRequestID, input.OldReviewerID); err != nil {
-			return err
-		}
-		if _, err := tx.Exec(ctx, `
-            INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id)
-            VALUES ($1, $2)
-        `, input.PullRequestID, newReviewer); err != nil {
+		if err := s.replaceReviewer(ctx, tx, input.PullRequestID, input.OldReviewerID, newReviewer); err != nil {
 			return err
 		}
 
@@ -438,23 +823,29 @@ func (s *Service) ReassignReviewer(ctx context.Context, input ReassignInput) (Re
 			return err
 		}
 		result.PullRequest = updated
-		result.ReplacedBy = newReviewer
+		result.ReplacedBy = newReviewer.UserID
 		return nil
 	})
 	return result, err
 }
 
-func (s *Service) GetUserReviews(ctx context.Context, userID string) ([]domain.PullRequestShort, error) {
+// GetUserReviews lists the pull requests the user reviews. With pendingOnly
+// it keeps only OPEN pull requests the user has not decided on yet.
+func (s *Service) GetUserReviews(ctx context.Context, userID string, pendingOnly bool) ([]domain.PullRequestShort, error) {
 	if _, err := s.getUser(ctx, s.db, userID); err != nil {
 		return nil, err
 	}
 	rows, err := s.db.Query(ctx, `
-        SELECT pr.id, pr.name, pr.author_id, pr.status, pr.created_at
+        SELECT pr.id, pr.name, pr.description, pr.repository, pr.number, pr.lines_added, pr.lines_removed, pr.files_changed,
+               COALESCE((SELECT array_agg(label ORDER BY label) FROM pull_request_labels WHERE pull_request_id = pr.id), '{}'),
+               pr.author_id, pr.status,
+               CASE WHEN r.state = 'PENDING' AND pr.status = 'OPEN' THEN r.round END,
+               pr.created_at
         FROM pull_requests pr
         JOIN pull_request_reviewers r ON r.pull_request_id = pr.id
-        WHERE r.reviewer_id = $1
+        WHERE r.reviewer_id = $1 AND (NOT $2 OR (r.state = 'PENDING' AND pr.status = 'OPEN'))
         ORDER BY pr.created_at DESC
-    `, userID)
+    `, userID, pendingOnly)
 	if err != nil {
 		return nil, err
 	}
@@ -463,9 +854,12 @@ func (s *Service) GetUserReviews(ctx context.Context, userID string) ([]domain.P
 	var prs []domain.PullRequestShort
 	for rows.Next() {
 		var pr domain.PullRequestShort
-		if err := rows.Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt); err != nil {
+		var added, removed, files *int
+		if err := rows.Scan(&pr.ID, &pr.Name, &pr.Description, &pr.Repository, &pr.Number, &added, &removed, &files, &pr.Labels,
+			&pr.AuthorID, &pr.Status, &pr.WaitingRound, &pr.CreatedAt); err != nil {
 			return nil, err
 		}
+		pr.Size = diffSize(added, removed, files)
 		prs = append(prs, pr)
 	}
 	if rows.Err() != nil {
@@ -478,10 +872,13 @@ func (s *Service) GetUserReviews(ctx context.Context, userID string) ([]domain.P
 func (s *Service) getUser(ctx context.Context, q dbExecutor, userID string) (domain.User, error) {
 	var user domain.User
 	err := q.QueryRow(ctx, `
-        SELECT id, username, team_name, is_active
-        FROM users
-        WHERE id = $1
-    `, userID).Scan(&user.ID, &user.Username, &user.TeamName, &user.IsActive)
+        SELECT u.id, u.username, u.team_name, u.is_active, u.role, u.max_open_reviews, u.review_weight,
+               COALESCE((SELECT array_agg(skill ORDER BY skill) FROM user_skills WHERE user_id = u.id), '{}'),
+               u.timezone, COALESCE(to_char(u.work_start, 'HH24:MI'), ''), COALESCE(to_char(u.work_end, 'HH24:MI'), '')
+        FROM users u
+        WHERE u.id = $1
+    `, userID).Scan(&user.ID, &user.Username, &user.TeamName, &user.IsActive, &user.Role, &user.MaxOpenReviews, &user.ReviewWeight, &user.Skills,
+		&user.Timezone, &user.WorkStart, &user.WorkEnd)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.User{}, domain.ErrUserNotFound
@@ -493,17 +890,22 @@ func (s *Service) getUser(ctx context.Context, q dbExecutor, userID string) (dom
 
 func (s *Service) GetPullRequest(ctx context.Context, q dbExecutor, prID string) (domain.PullRequest, error) {
 	var pr domain.PullRequest
+	var added, removed, files *int
 	err := q.QueryRow(ctx, `
-        SELECT id, name, author_id, status, created_at, merged_at
+        SELECT id, name, description, repository, number, lines_added, lines_removed, files_changed,
+               author_id, status, version, review_round, created_at, merged_at, closed_at,
+               EXISTS(SELECT 1 FROM merge_overrides WHERE pull_request_id = pull_requests.id)
         FROM pull_requests
         WHERE id = $1
-    `, prID).Scan(&pr.ID, &pr.Name, &pr.AuthorID, &pr.Status, &pr.CreatedAt, &pr.MergedAt)
+    `, prID).Scan(&pr.ID, &pr.Name, &pr.Description, &pr.Repository, &pr.Number, &added, &removed, &files,
+		&pr.AuthorID, &pr.Status, &pr.Version, &pr.ReviewRound, &pr.CreatedAt, &pr.MergedAt, &pr.ClosedAt, &pr.MergeOverride)
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return domain.PullRequest{}, domain.ErrPullRequestNotFound
 		}
 		return domain.PullRequest{}, err
 	}
+	pr.Size = diffSize(added, removed, files)
 
 	reviewers, err := s.listReviewers(ctx, q, prID)
 	if err != nil {
@@ -511,6 +913,36 @@ func (s *Service) GetPullRequest(ctx context.Context, q dbExecutor, prID string)
 	}
 	pr.AssignedReviewers = reviewers
 
+	fallback, err := s.listFlaggedReviewers(ctx, q, prID, "is_fallback")
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	pr.FallbackReviewers = fallback
+
+	pinned, err := s.listFlaggedReviewers(ctx, q, prID, "is_pinned")
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	pr.PinnedReviewers = pinned
+
+	reviews, err := s.listReviews(ctx, q, prID)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	pr.Reviews = reviews
+
+	history, err := s.listReviewHistory(ctx, q, prID)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	pr.ReviewHistory = history
+
+	labels, err := s.listPullRequestLabels(ctx, q, prID)
+	if err != nil {
+		return domain.PullRequest{}, err
+	}
+	pr.Labels = labels
+
 	return pr, nil
 }
 
@@ -540,73 +972,447 @@ func (s *Service) listReviewers(ctx context.Context, q dbExecutor, prID string)
 	return reviewers, nil
 }
 
-func (s *Service) pickReviewers(ctx context.Context, q dbExecutor, teamName, excludeUser string, limit int) ([]string, error) {
+// listFlaggedReviewers lists reviewers whose boolean column flag is set.
+func (s *Service) listFlaggedReviewers(ctx context.Context, q dbExecutor, prID, flag string) ([]string, error) {
 	rows, err := q.Query(ctx, `
-        SELECT id
-        FROM users
-        WHERE team_name = $1 AND is_active = true AND id <> $2
-    `, teamName, excludeUser)
+        SELECT reviewer_id
+        FROM pull_request_reviewers
+        WHERE pull_request_id = $1 AND `+flag+` = true
+        ORDER BY reviewer_id
+    `, prID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
-	var candidates []string
+	var reviewers []string
 	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
+		var reviewer string
+		if err := rows.Scan(&reviewer); err != nil {
 			return nil, err
 		}
-		candidates = append(candidates, id)
+		reviewers = append(reviewers, reviewer)
 	}
 	if rows.Err() != nil {
 		return nil, rows.Err()
 	}
+	return reviewers, nil
+}
 
-	if len(candidates) <= limit {
-		return candidates, nil
+func (s *Service) addReviewer(ctx context.Context, q dbExecutor, prID string, reviewer pickedReviewer) error {
+	_, err := q.Exec(ctx, `
+        INSERT INTO pull_request_reviewers (pull_request_id, reviewer_id, is_fallback, is_pinned, assigned_at, round)
+        VALUES ($1, $2, $3, $4, $5, (SELECT review_round FROM pull_requests WHERE id = $1))
+    `, prID, reviewer.UserID, reviewer.Fallback, reviewer.Pinned, s.now())
+	if err != nil {
+		return err
 	}
+	return s.recordPairing(ctx, q, prID, reviewer.UserID)
+}
 
-	shuffled := make([]string, len(candidates))
-	copy(shuffled, candidates)
-	r := rand.New(rand.NewSource(time.Now().UnixNano()))
-	r.Shuffle(len(shuffled), func(i, j int) { shuffled[i], shuffled[j] = shuffled[j], shuffled[i] })
-	return shuffled[:limit], nil
+// replaceReviewer puts reviewer into oldReviewer's seat. The seat does not
+// inherit the pin: a pinned reviewer that leaves (e.g. by declining) is
+// replaced by an automatic pick, which automatic flows may move again. Nor
+// does it inherit the fallback flag, which is worked out for the new reviewer.
+func (s *Service) replaceReviewer(ctx context.Context, q dbExecutor, prID, oldReviewer string, reviewer pickedReviewer) error {
+	fallback, err := s.isFallbackReviewer(ctx, q, prID, reviewer.UserID)
+	if err != nil {
+		return err
+	}
+	if err := s.archiveReviews(ctx, q, prID, oldReviewer); err != nil {
+		return err
+	}
+	_, err = q.Exec(ctx, `
+        UPDATE pull_request_reviewers
+        SET reviewer_id = $3,
+            is_fallback = $4,
+            is_pinned = $6,
+            state = 'PENDING',
+            assigned_at = $5,
+            decided_at = NULL,
+            round = (SELECT review_round FROM pull_requests WHERE id = $1)
+        WHERE pull_request_id = $1 AND reviewer_id = $2
+    `, prID, oldReviewer, reviewer.UserID, fallback, s.now(), reviewer.Pinned)
+	if err != nil {
+		return err
+	}
+	if err := s.bumpPullRequestVersion(ctx, q, prID); err != nil {
+		return err
+	}
+	return s.recordPairing(ctx, q, prID, reviewer.UserID)
 }
 
-func (s *Service) pickReplacementCandidates(ctx context.Context, q dbExecutor, teamName string, assigned []string, oldReviewer string) ([]string, error) {
-	rows, err := q.Query(ctx, `
-        SELECT id
-        FROM users
-        WHERE team_name = $1 AND is_active = true
-    `, teamName)
+// isFallbackReviewer reports whether the user is outside the team the pull
+// request takes its reviewers from: the repository default team or, without
+// one, the author's team.
+func (s *Service) isFallbackReviewer(ctx context.Context, q dbExecutor, prID, userID string) (bool, error) {
+	var fallback bool
+	err := q.QueryRow(ctx, `
+        SELECT COALESCE(r.default_team, a.team_name) IS DISTINCT FROM u.team_name
+        FROM pull_requests pr
+        JOIN users a ON a.id = pr.author_id
+        JOIN repositories r ON r.name = pr.repository
+        JOIN users u ON u.id = $2
+        WHERE pr.id = $1
+    `, prID, userID).Scan(&fallback)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, domain.ErrPullRequestNotFound
+		}
+		return false, err
+	}
+	return fallback, nil
+}
+
+func (s *Service) selectorFor(ctx context.Context, q dbExecutor, teamName string) (ReviewerSelector, error) {
+	var strategy string
+	err := q.QueryRow(ctx, `SELECT reviewer_strategy FROM teams WHERE name = $1`, teamName).Scan(&strategy)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, domain.ErrTeamNotFound
+		}
 		return nil, err
 	}
-	defer rows.Close()
 
-	assignedSet := make(map[string]struct{}, len(assigned))
-	for _, id := range assigned {
-		assignedSet[id] = struct{}{}
+	if selector, ok := s.selectors[strategy]; ok {
+		return selector, nil
+	}
+	return s.selectors[DefaultReviewerStrategy], nil
+}
+
+func (s *Service) loadCandidates(ctx context.Context, q dbExecutor, teamName string) ([]Candidate, error) {
+	return s.queryCandidates(ctx, q, `u.team_name = $1`, teamName)
+}
+
+func (s *Service) loadUserCandidates(ctx context.Context, q dbExecutor, userIDs []string) ([]Candidate, error) {
+	return s.queryCandidates(ctx, q, `u.id = ANY($1)`, userIDs)
+}
+
+func (s *Service) queryCandidates(ctx context.Context, q dbExecutor, filter string, arg any) ([]Candidate, error) {
+	rows, err := q.Query(ctx, `
+        SELECT u.id, u.team_name, u.role, u.max_open_reviews, u.review_weight, COUNT(pr.id),
+               COALESCE((SELECT array_agg(skill ORDER BY skill) FROM user_skills WHERE user_id = u.id), '{}'),
+               u.timezone, COALESCE(to_char(u.work_start, 'HH24:MI'), ''), COALESCE(to_char(u.work_end, 'HH24:MI'), '')
+        FROM users u
+        LEFT JOIN pull_request_reviewers r ON r.reviewer_id = u.id
+        LEFT JOIN pull_requests pr ON pr.id = r.pull_request_id AND pr.status = 'OPEN'
+        WHERE u.is_active = true AND `+filter+`
+        GROUP BY u.id
+        ORDER BY u.id
+    `, arg)
+	if err != nil {
+		return nil, err
 	}
+	defer rows.Close()
 
-	var candidates []string
+	now := s.now()
+	var candidates []Candidate
 	for rows.Next() {
-		var id string
-		if err := rows.Scan(&id); err != nil {
+		var c Candidate
+		var timezone, workStart, workEnd string
+		if err := rows.Scan(&c.UserID, &c.TeamName, &c.Role, &c.MaxOpenReviews, &c.Weight, &c.OpenReviews, &c.Skills,
+			&timezone, &workStart, &workEnd); err != nil {
 			return nil, err
 		}
-		if id == oldReviewer {
+		c.Available = inWorkingHours(now, s.availableWithin, timezone, workStart, workEnd)
+		candidates = append(candidates, c)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return candidates, nil
+}
+
+// eligibleCandidates drops excluded users and users already at their review
+// capacity; the latter are recorded in atCapacity so callers can report them.
+func eligibleCandidates(loaded []Candidate, excluded, atCapacity map[string]struct{}) []Candidate {
+	candidates := make([]Candidate, 0, len(loaded))
+	for _, c := range loaded {
+		if _, skip := excluded[c.UserID]; skip {
 			continue
 		}
-		if _, exists := assignedSet[id]; exists {
+		if c.AtCapacity() {
+			atCapacity[c.UserID] = struct{}{}
 			continue
 		}
-		candidates = append(candidates, id)
+		candidates = append(candidates, c)
 	}
-	if rows.Err() != nil {
-		return nil, rows.Err()
+	return candidates
+}
+
+func seniorCandidates(loaded []Candidate) []Candidate {
+	seniors := make([]Candidate, 0, len(loaded))
+	for _, c := range loaded {
+		if c.Senior() {
+			seniors = append(seniors, c)
+		}
 	}
+	return seniors
+}
 
-	return candidates, nil
+func validRole(role string) bool {
+	switch role {
+	case domain.RoleJunior, domain.RoleMiddle, domain.RoleSenior, domain.RoleLead:
+		return true
+	default:
+		return false
+	}
+}
+
+// seniorRequired reports whether the team's senior reviewer minimum would be
+// broken if oldReviewer were replaced by a non-senior.
+func (s *Service) seniorRequired(ctx context.Context, q dbExecutor, prID, oldReviewer string) (bool, error) {
+	_, settings, err := s.pullRequestSettings(ctx, q, prID)
+	if err != nil {
+		return false, err
+	}
+	if settings.MinSeniorReviewers == 0 {
+		return false, nil
+	}
+
+	var oldSenior bool
+	var remaining int
+	err = q.QueryRow(ctx, `
+        SELECT COALESCE(bool_or(u.id = $2), false), COUNT(*) FILTER (WHERE u.id <> $2)
+        FROM pull_request_reviewers r
+        JOIN users u ON u.id = r.reviewer_id
+        WHERE r.pull_request_id = $1 AND u.role IN ('senior', 'lead')
+    `, prID, oldReviewer).Scan(&oldSenior, &remaining)
+	if err != nil {
+		return false, err
+	}
+	return oldSenior && remaining < settings.MinSeniorReviewers, nil
+}
+
+func (s *Service) pickReviewers(ctx context.Context, q dbExecutor, req reviewerRequest) (reviewerPick, error) {
+	selector, err := s.selectorFor(ctx, q, req.TeamName)
+	if err != nil {
+		return reviewerPick{}, err
+	}
+
+	r := s.randFor(req.PullRequestID)
+	var result reviewerPick
+	excluded := map[string]struct{}{req.AuthorID: {}}
+	atCapacity := make(map[string]struct{})
+	chosen := make(map[string]struct{})
+	seniors := 0
+
+	// A reopened pull request keeps its declines; decliners are never picked
+	// for it again.
+	declined, err := s.listDeclinedReviewers(ctx, q, req.PullRequestID)
+	if err != nil {
+		return reviewerPick{}, err
+	}
+	for _, id := range declined {
+		excluded[id] = struct{}{}
+	}
+
+	owners, err := s.resolvePathOwners(ctx, q, req.Paths)
+	if err != nil {
+		return reviewerPick{}, err
+	}
+	for _, path := range sortedPaths(owners) {
+		if isCovered(owners[path], chosen) {
+			continue
+		}
+		loaded, err := s.loadUserCandidates(ctx, q, owners[path])
+		if err != nil {
+			return reviewerPick{}, err
+		}
+		candidates := withPairings(eligibleCandidates(loaded, excluded, atCapacity), req.Pairings)
+		ids := selectPreferred(selector, r, SelectionRequest{
+			TeamName:   req.TeamName,
+			AuthorID:   req.AuthorID,
+			Limit:      1,
+			Labels:     req.Labels,
+			Candidates: candidates,
+		})
+		if len(ids) == 0 {
+			result.Uncovered = append(result.Uncovered, path)
+			continue
+		}
+		for _, c := range candidates {
+			if c.UserID == ids[0] && c.Senior() {
+				seniors++
+			}
+		}
+		excluded[ids[0]] = struct{}{}
+		chosen[ids[0]] = struct{}{}
+		result.Reviewers = append(result.Reviewers, pickedReviewer{UserID: ids[0]})
+	}
+
+	fallbackTeams, err := s.listFallbackTeams(ctx, q, req.TeamName)
+	if err != nil {
+		return reviewerPick{}, err
+	}
+	teams := append([]string{req.TeamName}, fallbackTeams...)
+	fill := func(limit int, seniorOnly bool) (int, error) {
+		added := 0
+		for i, team := range teams {
+			if added >= limit {
+				break
+			}
+
+			loaded, err := s.loadCandidates(ctx, q, team)
+			if err != nil {
+				return added, err
+			}
+			if seniorOnly {
+				loaded = seniorCandidates(loaded)
+			}
+			ids := selectPreferred(selector, r, SelectionRequest{
+				TeamName:   req.TeamName,
+				AuthorID:   req.AuthorID,
+				Limit:      limit - added,
+				Labels:     req.Labels,
+				Candidates: withPairings(eligibleCandidates(loaded, excluded, atCapacity), req.Pairings),
+			})
+			for _, id := range ids {
+				excluded[id] = struct{}{}
+				result.Reviewers = append(result.Reviewers, pickedReviewer{UserID: id, Fallback: i > 0})
+			}
+			added += len(ids)
+		}
+		return added, nil
+	}
+
+	if missing := req.MinSeniors - seniors; missing > 0 {
+		added, err := fill(min(missing, req.Limit-len(result.Reviewers)), true)
+		if err != nil {
+			return reviewerPick{}, err
+		}
+		result.MissingSeniors = missing - added
+	}
+	if _, err := fill(req.Limit-len(result.Reviewers), false); err != nil {
+		return reviewerPick{}, err
+	}
+
+	for id := range atCapacity {
+		result.AtCapacity = append(result.AtCapacity, id)
+	}
+	sort.Strings(result.AtCapacity)
+	return result, nil
+}
+
+// pickReplacement picks a reviewer to take over from oldReviewer. assigned
+// holds the current reviewers only; the author is passed separately so that
+// they are excluded from the pick but never count as a path owner.
+func (s *Service) pickReplacement(ctx context.Context, q dbExecutor, prID, teamName, authorID string, assigned []string, oldReviewer string) (pickedReviewer, error) {
+	selector, err := s.selectorFor(ctx, q, teamName)
+	if err != nil {
+		return pickedReviewer{}, err
+	}
+
+	r := s.randFor(prID, oldReviewer)
+	excluded := make(map[string]struct{}, len(assigned)+2)
+	for _, id := range assigned {
+		excluded[id] = struct{}{}
+	}
+	excluded[oldReviewer] = struct{}{}
+	excluded[authorID] = struct{}{}
+	atCapacity := make(map[string]struct{})
+
+	declined, err := s.listDeclinedReviewers(ctx, q, prID)
+	if err != nil {
+		return pickedReviewer{}, err
+	}
+	for _, id := range declined {
+		excluded[id] = struct{}{}
+	}
+
+	paths, err := s.listPullRequestFiles(ctx, q, prID)
+	if err != nil {
+		return pickedReviewer{}, err
+	}
+	labels, err := s.listPullRequestLabels(ctx, q, prID)
+	if err != nil {
+		return pickedReviewer{}, err
+	}
+	pairings, err := s.pullRequestPairings(ctx, q, prID)
+	if err != nil {
+		return pickedReviewer{}, err
+	}
+	seniorOnly, err := s.seniorRequired(ctx, q, prID, oldReviewer)
+	if err != nil {
+		return pickedReviewer{}, err
+	}
+	owners, err := s.resolvePathOwners(ctx, q, paths)
+	if err != nil {
+		return pickedReviewer{}, err
+	}
+	remaining := make(map[string]struct{}, len(assigned))
+	for _, id := range assigned {
+		if id != oldReviewer {
+			remaining[id] = struct{}{}
+		}
+	}
+	var ownerIDs []string
+	for _, path := range sortedPaths(uncoveredOwners(owners, remaining)) {
+		ownerIDs = append(ownerIDs, owners[path]...)
+	}
+	if len(ownerIDs) > 0 {
+		loaded, err := s.loadUserCandidates(ctx, q, ownerIDs)
+		if err != nil {
+			return pickedReviewer{}, err
+		}
+		if seniorOnly {
+			loaded = seniorCandidates(loaded)
+		}
+		ids := selectPreferred(selector, r, SelectionRequest{
+			TeamName:   teamName,
+			Limit:      1,
+			Labels:     labels,
+			Candidates: withPairings(eligibleCandidates(loaded, excluded, atCapacity), pairings),
+		})
+		if len(ids) > 0 {
+			return pickedReviewer{UserID: ids[0]}, nil
+		}
+	}
+
+	fallbackTeams, err := s.listFallbackTeams(ctx, q, teamName)
+	if err != nil {
+		return pickedReviewer{}, err
+	}
+	skipped := append(append(append([]string{}, assigned...), declined...), authorID)
+	for _, team := range append([]string{teamName}, fallbackTeams...) {
+		candidates, err := s.pickReplacementCandidates(ctx, q, team, skipped, oldReviewer, seniorOnly, atCapacity)
+		if err != nil {
+			return pickedReviewer{}, err
+		}
+		ids := selectPreferred(selector, r, SelectionRequest{
+			TeamName:   teamName,
+			Limit:      1,
+			Labels:     labels,
+			Candidates: withPairings(candidates, pairings),
+		})
+		if len(ids) > 0 {
+			return pickedReviewer{UserID: ids[0]}, nil
+		}
+	}
+
+	if len(atCapacity) > 0 {
+		return pickedReviewer{}, domain.ErrReviewersAtCapacity
+	}
+	if seniorOnly {
+		return pickedReviewer{}, domain.ErrNoSeniorCandidate
+	}
+	return pickedReviewer{}, domain.ErrNoCandidate
+}
+
+func (s *Service) pickReplacementCandidates(ctx context.Context, q dbExecutor, teamName string, assigned []string, oldReviewer string, seniorOnly bool, atCapacity map[string]struct{}) ([]Candidate, error) {
+	loaded, err := s.loadCandidates(ctx, q, teamName)
+	if err != nil {
+		return nil, err
+	}
+	if seniorOnly {
+		loaded = seniorCandidates(loaded)
+	}
+
+	excluded := make(map[string]struct{}, len(assigned)+1)
+	for _, id := range assigned {
+		excluded[id] = struct{}{}
+	}
+	excluded[oldReviewer] = struct{}{}
+
+	return eligibleCandidates(loaded, excluded, atCapacity), nil
 }
@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/tdenkov123/avitotech_internship_2025/internal/domain"
+)
+
+const (
+	defaultReviewerCount    = 2
+	defaultPairingWindowPRs = 3
+)
+
+type UpdateTeamSettingsInput struct {
+	TeamName           string
+	ReviewerCount      *int
+	MinReviewers       *int
+	MinReviewersPolicy *string
+	MinSeniorReviewers *int
+	RequiredApprovals  *int
+	MaxOpenPRs         *int
+	FallbackTeams      []string
+	PairingWindowPRs   *int
+	PairingWindowDays  *int
+}
+
+func defaultTeamSettings(teamName string) domain.TeamSettings {
+	return domain.TeamSettings{
+		TeamName:           teamName,
+		ReviewerCount:      defaultReviewerCount,
+		MinReviewers:       0,
+		MinReviewersPolicy: domain.MinReviewersPolicyWarn,
+		PairingWindowPRs:   defaultPairingWindowPRs,
+	}
+}
+
+func (s *Service) GetTeamSettings(ctx context.Context, teamName string) (domain.TeamSettings, error) {
+	return s.getTeamSettings(ctx, s.db, teamName)
+}
+
+func (s *Service) UpdateTeamSettings(ctx context.Context, input UpdateTeamSettingsInput) (domain.TeamSettings, error) {
+	var result domain.TeamSettings
+	err := s.withTx(ctx, func(tx pgx.Tx) error {
+		settings, err := s.getTeamSettings(ctx, tx, input.TeamName)
+		if err != nil {
+			return err
+		}
+
+		if input.ReviewerCount != nil {
+			settings.ReviewerCount = *input.ReviewerCount
+		}
+		if input.MinReviewers != nil {
+			settings.MinReviewers = *input.MinReviewers
+		}
+		if input.MinReviewersPolicy != nil {
+			settings.MinReviewersPolicy = *input.MinReviewersPolicy
+		}
+		if input.MinSeniorReviewers != nil {
+			settings.MinSeniorReviewers = *input.MinSeniorReviewers
+		}
+		if input.RequiredApprovals != nil {
+			settings.RequiredApprovals = *input.RequiredApprovals
+		}
+		if input.MaxOpenPRs != nil {
+			settings.MaxOpenPRs = *input.MaxOpenPRs
+		}
+		if input.FallbackTeams != nil {
+			settings.FallbackTeams = input.FallbackTeams
+		}
+		if input.PairingWindowPRs != nil {
+			settings.PairingWindowPRs = *input.PairingWindowPRs
+		}
+		if input.PairingWindowDays != nil {
+			settings.PairingWindowDays = *input.PairingWindowDays
+		}
+		if err := validateTeamSettings(settings); err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(ctx, `
+            INSERT INTO team_settings (team_name, reviewer_count, min_reviewers, min_reviewers_policy,
+                                       pairing_window_prs, pairing_window_days, min_senior_reviewers,
+                                       required_approvals, max_open_pull_requests)
+            VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+            ON CONFLICT (team_name) DO UPDATE
+            SET reviewer_count = EXCLUDED.reviewer_count,
+                min_reviewers = EXCLUDED.min_reviewers,
+                min_reviewers_policy = EXCLUDED.min_reviewers_policy,
+                pairing_window_prs = EXCLUDED.pairing_window_prs,
+                pairing_window_days = EXCLUDED.pairing_window_days,
+                min_senior_reviewers = EXCLUDED.min_senior_reviewers,
+                required_approvals = EXCLUDED.required_approvals,
+                max_open_pull_requests = EXCLUDED.max_open_pull_requests
+        `, settings.TeamName, settings.ReviewerCount, settings.MinReviewers, settings.MinReviewersPolicy,
+			settings.PairingWindowPRs, settings.PairingWindowDays, settings.MinSeniorReviewers, settings.RequiredApprovals,
+			settings.MaxOpenPRs)
+		if err != nil {
+			return err
+		}
+
+		if input.FallbackTeams != nil {
+			if err := s.replaceFallbackTeams(ctx, tx, settings.TeamName, settings.FallbackTeams); err != nil {
+				return err
+			}
+		}
+		result = settings
+		return nil
+	})
+	if err != nil {
+		return domain.TeamSettings{}, err
+	}
+	return result, nil
+}
+
+func validateTeamSettings(settings domain.TeamSettings) error {
+	seen := make(map[string]struct{}, len(settings.FallbackTeams))
+	for _, team := range settings.FallbackTeams {
+		if team == "" || team == settings.TeamName {
+			return domain.ErrInvalidInput
+		}
+		if _, dup := seen[team]; dup {
+			return domain.ErrInvalidInput
+		}
+		seen[team] = struct{}{}
+	}
+
+	if settings.ReviewerCount < 0 || settings.MinReviewers < 0 || settings.MinReviewers > settings.ReviewerCount {
+		return domain.ErrInvalidInput
+	}
+	if settings.PairingWindowPRs < 0 || settings.PairingWindowDays < 0 {
+		return domain.ErrInvalidInput
+	}
+	if settings.MinSeniorReviewers < 0 || settings.MinSeniorReviewers > settings.ReviewerCount {
+		return domain.ErrInvalidInput
+	}
+	if settings.RequiredApprovals < 0 || settings.MaxOpenPRs < 0 {
+		return domain.ErrInvalidInput
+	}
+	switch settings.MinReviewersPolicy {
+	case domain.MinReviewersPolicyWarn, domain.MinReviewersPolicyReject:
+		return nil
+	default:
+		return domain.ErrInvalidInput
+	}
+}
+
+func (s *Service) getTeamSettings(ctx context.Context, q dbExecutor, teamName string) (domain.TeamSettings, error) {
+	var exists bool
+	if err := q.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE name = $1)`, teamName).Scan(&exists); err != nil {
+		return domain.TeamSettings{}, err
+	}
+	if !exists {
+		return domain.TeamSettings{}, domain.ErrTeamNotFound
+	}
+
+	settings := defaultTeamSettings(teamName)
+	err := q.QueryRow(ctx, `
+        SELECT reviewer_count, min_reviewers, min_reviewers_policy, pairing_window_prs, pairing_window_days,
+               min_senior_reviewers, required_approvals, max_open_pull_requests
+        FROM team_settings
+        WHERE team_name = $1
+    `, teamName).Scan(&settings.ReviewerCount, &settings.MinReviewers, &settings.MinReviewersPolicy,
+		&settings.PairingWindowPRs, &settings.PairingWindowDays, &settings.MinSeniorReviewers, &settings.RequiredApprovals,
+		&settings.MaxOpenPRs)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return domain.TeamSettings{}, err
+	}
+
+	fallbackTeams, err := s.listFallbackTeams(ctx, q, teamName)
+	if err != nil {
+		return domain.TeamSettings{}, err
+	}
+	settings.FallbackTeams = fallbackTeams
+	return settings, nil
+}
+
+// pullRequestSettings returns the author of a pull request together with the
+// settings that apply to it: those of the repository's default team (or the
+// author's team) with the repository overrides on top.
+func (s *Service) pullRequestSettings(ctx context.Context, q dbExecutor, prID string) (string, domain.TeamSettings, error) {
+	var authorID, teamName, repoName string
+	err := q.QueryRow(ctx, `
+        SELECT pr.author_id, u.team_name, pr.repository
+        FROM pull_requests pr
+        JOIN users u ON u.id = pr.author_id
+        WHERE pr.id = $1
+    `, prID).Scan(&authorID, &teamName, &repoName)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", domain.TeamSettings{}, domain.ErrPullRequestNotFound
+		}
+		return "", domain.TeamSettings{}, err
+	}
+	repo, err := s.getRepository(ctx, q, repoName)
+	if err != nil {
+		return "", domain.TeamSettings{}, err
+	}
+	if repo.DefaultTeam != "" {
+		teamName = repo.DefaultTeam
+	}
+	settings, err := s.getTeamSettings(ctx, q, teamName)
+	if err != nil {
+		return "", domain.TeamSettings{}, err
+	}
+	applyRepositorySettings(&settings, repo)
+	return authorID, settings, nil
+}
+
+func (s *Service) listFallbackTeams(ctx context.Context, q dbExecutor, teamName string) ([]string, error) {
+	rows, err := q.Query(ctx, `
+        SELECT fallback_team_name
+        FROM team_fallbacks
+        WHERE team_name = $1
+        ORDER BY position
+    `, teamName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	teams := make([]string, 0)
+	for rows.Next() {
+		var team string
+		if err := rows.Scan(&team); err != nil {
+			return nil, err
+		}
+		teams = append(teams, team)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return teams, nil
+}
+
+func (s *Service) replaceFallbackTeams(ctx context.Context, q dbExecutor, teamName string, fallbackTeams []string) error {
+	if _, err := q.Exec(ctx, `DELETE FROM team_fallbacks WHERE team_name = $1`, teamName); err != nil {
+		return err
+	}
+	for i, fallback := range fallbackTeams {
+		var exists bool
+		if err := q.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM teams WHERE name = $1)`, fallback).Scan(&exists); err != nil {
+			return err
+		}
+		if !exists {
+			return domain.ErrTeamNotFound
+		}
+		if _, err := q.Exec(ctx, `
+            INSERT INTO team_fallbacks (team_name, fallback_team_name, position)
+            VALUES ($1, $2, $3)
+        `, teamName, fallback, i); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"sort"
+	"strings"
+)
+
+func (s *Service) replaceUserSkills(ctx context.Context, q dbExecutor, userID string, skills []string) error {
+	if _, err := q.Exec(ctx, `DELETE FROM user_skills WHERE user_id = $1`, userID); err != nil {
+		return err
+	}
+	for _, skill := range normalizeTags(skills) {
+		if _, err := q.Exec(ctx, `
+            INSERT INTO user_skills (user_id, skill)
+            VALUES ($1, $2)
+        `, userID, skill); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) replacePullRequestLabels(ctx context.Context, q dbExecutor, prID string, labels []string) error {
+	if _, err := q.Exec(ctx, `DELETE FROM pull_request_labels WHERE pull_request_id = $1`, prID); err != nil {
+		return err
+	}
+	for _, label := range labels {
+		if _, err := q.Exec(ctx, `
+            INSERT INTO pull_request_labels (pull_request_id, label)
+            VALUES ($1, $2)
+        `, prID, label); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Service) listPullRequestLabels(ctx context.Context, q dbExecutor, prID string) ([]string, error) {
+	rows, err := q.Query(ctx, `
+        SELECT label
+        FROM pull_request_labels
+        WHERE pull_request_id = $1
+        ORDER BY label
+    `, prID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	labels := make([]string, 0)
+	for rows.Next() {
+		var label string
+		if err := rows.Scan(&label); err != nil {
+			return nil, err
+		}
+		labels = append(labels, label)
+	}
+	if rows.Err() != nil {
+		return nil, rows.Err()
+	}
+	return labels, nil
+}
+
+func normalizeTags(tags []string) []string {
+	seen := make(map[string]struct{}, len(tags))
+	result := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" {
+			continue
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		result = append(result, tag)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE teams DROP COLUMN IF EXISTS reviewer_strategy;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE teams
+    ADD COLUMN reviewer_strategy TEXT NOT NULL DEFAULT 'random';
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+CREATE TABLE team_settings (
+    team_name TEXT PRIMARY KEY REFERENCES teams(name) ON DELETE CASCADE,
+    reviewer_count INTEGER NOT NULL DEFAULT 2,
+    min_reviewers INTEGER NOT NULL DEFAULT 0,
+    min_reviewers_policy TEXT NOT NULL DEFAULT 'WARN',
+    CHECK (reviewer_count >= 0),
+    CHECK (min_reviewers >= 0 AND min_reviewers <= reviewer_count),
+    CHECK (min_reviewers_policy IN ('WARN', 'REJECT'))
+);
+
+COMMIT;
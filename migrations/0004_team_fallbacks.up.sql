@@ -0,0 +1,14 @@
+BEGIN;
+
+CREATE TABLE team_fallbacks (
+    team_name TEXT NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    fallback_team_name TEXT NOT NULL REFERENCES teams(name) ON DELETE CASCADE,
+    position INTEGER NOT NULL,
+    PRIMARY KEY (team_name, fallback_team_name),
+    CHECK (team_name <> fallback_team_name)
+);
+
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN is_fallback BOOLEAN NOT NULL DEFAULT FALSE;
+
+COMMIT;
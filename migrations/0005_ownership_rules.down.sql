@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS pull_request_files;
+DROP TABLE IF EXISTS ownership_rules;
+
+COMMIT;
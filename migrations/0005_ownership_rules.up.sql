@@ -0,0 +1,17 @@
+BEGIN;
+
+CREATE TABLE ownership_rules (
+    id BIGSERIAL PRIMARY KEY,
+    pattern TEXT NOT NULL,
+    owner_user_id TEXT REFERENCES users(id) ON DELETE CASCADE,
+    owner_team_name TEXT REFERENCES teams(name) ON DELETE CASCADE,
+    CHECK ((owner_user_id IS NULL) <> (owner_team_name IS NULL))
+);
+
+CREATE TABLE pull_request_files (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    path TEXT NOT NULL,
+    PRIMARY KEY (pull_request_id, path)
+);
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS pull_request_labels;
+DROP TABLE IF EXISTS user_skills;
+
+COMMIT;
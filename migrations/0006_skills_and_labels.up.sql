@@ -0,0 +1,15 @@
+BEGIN;
+
+CREATE TABLE user_skills (
+    user_id TEXT NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    skill TEXT NOT NULL,
+    PRIMARY KEY (user_id, skill)
+);
+
+CREATE TABLE pull_request_labels (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    label TEXT NOT NULL,
+    PRIMARY KEY (pull_request_id, label)
+);
+
+COMMIT;
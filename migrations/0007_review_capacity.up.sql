@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE users
+    ADD COLUMN max_open_reviews INTEGER CHECK (max_open_reviews >= 0);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE team_settings
+    DROP COLUMN IF EXISTS pairing_window_days,
+    DROP COLUMN IF EXISTS pairing_window_prs;
+DROP TABLE IF EXISTS review_pairings;
+
+COMMIT;
@@ -0,0 +1,22 @@
+BEGIN;
+
+CREATE TABLE review_pairings (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    author_id TEXT NOT NULL REFERENCES users(id),
+    reviewer_id TEXT NOT NULL REFERENCES users(id),
+    assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_review_pairings_author ON review_pairings (author_id, assigned_at);
+
+INSERT INTO review_pairings (pull_request_id, author_id, reviewer_id, assigned_at)
+SELECT r.pull_request_id, pr.author_id, r.reviewer_id, pr.created_at
+FROM pull_request_reviewers r
+JOIN pull_requests pr ON pr.id = r.pull_request_id;
+
+ALTER TABLE team_settings
+    ADD COLUMN pairing_window_prs INTEGER NOT NULL DEFAULT 3 CHECK (pairing_window_prs >= 0),
+    ADD COLUMN pairing_window_days INTEGER NOT NULL DEFAULT 0 CHECK (pairing_window_days >= 0);
+
+COMMIT;
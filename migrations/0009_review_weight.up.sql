@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE users
+    ADD COLUMN review_weight INTEGER NOT NULL DEFAULT 1 CHECK (review_weight >= 0);
+
+COMMIT;
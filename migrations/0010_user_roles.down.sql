@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE team_settings DROP COLUMN IF EXISTS min_senior_reviewers;
+ALTER TABLE users DROP COLUMN IF EXISTS role;
+
+COMMIT;
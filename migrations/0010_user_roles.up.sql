@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE users
+    ADD COLUMN role TEXT NOT NULL DEFAULT 'middle'
+        CHECK (role IN ('junior', 'middle', 'senior', 'lead'));
+
+ALTER TABLE team_settings
+    ADD COLUMN min_senior_reviewers INTEGER NOT NULL DEFAULT 0 CHECK (min_senior_reviewers >= 0);
+
+COMMIT;
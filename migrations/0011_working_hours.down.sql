@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE users
+    DROP CONSTRAINT IF EXISTS users_working_hours_check,
+    DROP COLUMN IF EXISTS work_end,
+    DROP COLUMN IF EXISTS work_start,
+    DROP COLUMN IF EXISTS timezone;
+
+COMMIT;
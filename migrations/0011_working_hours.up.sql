@@ -0,0 +1,10 @@
+BEGIN;
+
+ALTER TABLE users
+    ADD COLUMN timezone TEXT NOT NULL DEFAULT 'UTC',
+    ADD COLUMN work_start TIME,
+    ADD COLUMN work_end TIME,
+    ADD CONSTRAINT users_working_hours_check
+        CHECK ((work_start IS NULL) = (work_end IS NULL) AND (work_start IS NULL OR work_start <> work_end));
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE pull_request_reviewers DROP COLUMN IF EXISTS is_pinned;
+
+COMMIT;
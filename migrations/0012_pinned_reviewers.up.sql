@@ -0,0 +1,6 @@
+BEGIN;
+
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN is_pinned BOOLEAN NOT NULL DEFAULT false;
+
+COMMIT;
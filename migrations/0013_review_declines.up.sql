@@ -0,0 +1,11 @@
+BEGIN;
+
+CREATE TABLE review_declines (
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    reviewer_id TEXT NOT NULL REFERENCES users(id),
+    reason TEXT NOT NULL CHECK (reason IN ('busy', 'conflict_of_interest', 'lacks_context')),
+    declined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (pull_request_id, reviewer_id)
+);
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE pull_request_reviewers
+    DROP COLUMN IF EXISTS decided_at,
+    DROP COLUMN IF EXISTS assigned_at,
+    DROP COLUMN IF EXISTS state;
+
+COMMIT;
@@ -0,0 +1,14 @@
+BEGIN;
+
+ALTER TABLE pull_request_reviewers
+    ADD COLUMN state TEXT NOT NULL DEFAULT 'PENDING'
+        CHECK (state IN ('PENDING', 'APPROVED', 'CHANGES_REQUESTED', 'COMMENTED')),
+    ADD COLUMN assigned_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    ADD COLUMN decided_at TIMESTAMPTZ;
+
+UPDATE pull_request_reviewers r
+SET assigned_at = pr.created_at
+FROM pull_requests pr
+WHERE pr.id = r.pull_request_id;
+
+COMMIT;
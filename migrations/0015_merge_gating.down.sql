@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE IF EXISTS merge_overrides;
+ALTER TABLE team_settings DROP COLUMN IF EXISTS required_approvals;
+
+COMMIT;
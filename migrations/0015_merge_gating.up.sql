@@ -0,0 +1,18 @@
+BEGIN;
+
+ALTER TABLE team_settings
+    ADD COLUMN required_approvals INTEGER NOT NULL DEFAULT 0 CHECK (required_approvals >= 0);
+
+CREATE TABLE merge_overrides (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    approvals INTEGER NOT NULL,
+    required_approvals INTEGER NOT NULL,
+    changes_requested INTEGER NOT NULL,
+    reason TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_merge_overrides_pr ON merge_overrides (pull_request_id);
+
+COMMIT;
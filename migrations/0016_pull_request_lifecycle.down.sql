@@ -0,0 +1,10 @@
+BEGIN;
+
+UPDATE pull_requests SET status = 'OPEN' WHERE status IN ('DRAFT', 'CLOSED');
+
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS closed_at;
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_status_check;
+ALTER TABLE pull_requests
+    ADD CONSTRAINT pull_requests_status_check CHECK (status IN ('OPEN', 'MERGED'));
+
+COMMIT;
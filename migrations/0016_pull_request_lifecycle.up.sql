@@ -0,0 +1,9 @@
+BEGIN;
+
+ALTER TABLE pull_requests DROP CONSTRAINT IF EXISTS pull_requests_status_check;
+ALTER TABLE pull_requests
+    ADD CONSTRAINT pull_requests_status_check CHECK (status IN ('DRAFT', 'OPEN', 'MERGED', 'CLOSED'));
+
+ALTER TABLE pull_requests ADD COLUMN closed_at TIMESTAMPTZ;
+
+COMMIT;
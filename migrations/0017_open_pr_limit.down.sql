@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP INDEX IF EXISTS idx_pull_requests_author_status;
+ALTER TABLE team_settings DROP COLUMN IF EXISTS max_open_pull_requests;
+
+COMMIT;
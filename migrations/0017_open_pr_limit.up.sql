@@ -0,0 +1,8 @@
+BEGIN;
+
+ALTER TABLE team_settings
+    ADD COLUMN max_open_pull_requests INTEGER NOT NULL DEFAULT 0 CHECK (max_open_pull_requests >= 0);
+
+CREATE INDEX IF NOT EXISTS idx_pull_requests_author_status ON pull_requests (author_id, status);
+
+COMMIT;
@@ -0,0 +1,11 @@
+BEGIN;
+
+ALTER TABLE pull_requests
+    DROP CONSTRAINT IF EXISTS pull_requests_diff_size_check,
+    DROP COLUMN IF EXISTS files_changed,
+    DROP COLUMN IF EXISTS lines_removed,
+    DROP COLUMN IF EXISTS lines_added,
+    DROP COLUMN IF EXISTS repository,
+    DROP COLUMN IF EXISTS description;
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE pull_requests
+    ADD COLUMN description TEXT NOT NULL DEFAULT '',
+    ADD COLUMN repository TEXT NOT NULL DEFAULT '',
+    ADD COLUMN lines_added INTEGER CHECK (lines_added >= 0),
+    ADD COLUMN lines_removed INTEGER CHECK (lines_removed >= 0),
+    ADD COLUMN files_changed INTEGER CHECK (files_changed >= 0),
+    ADD CONSTRAINT pull_requests_diff_size_check CHECK (
+        (lines_added IS NULL) = (lines_removed IS NULL) AND (lines_added IS NULL) = (files_changed IS NULL)
+    );
+
+COMMIT;
@@ -0,0 +1,13 @@
+BEGIN;
+
+ALTER TABLE pull_requests
+    DROP CONSTRAINT IF EXISTS pull_requests_repository_number_key,
+    DROP COLUMN IF EXISTS number,
+    DROP CONSTRAINT IF EXISTS pull_requests_repository_fkey,
+    ALTER COLUMN repository SET DEFAULT '';
+
+UPDATE pull_requests SET repository = '' WHERE repository = 'default';
+
+DROP TABLE IF EXISTS repositories;
+
+COMMIT;
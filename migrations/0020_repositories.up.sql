@@ -0,0 +1,25 @@
+BEGIN;
+
+CREATE TABLE repositories (
+    name TEXT PRIMARY KEY,
+    default_team TEXT REFERENCES teams(name) ON DELETE SET NULL,
+    reviewer_count INTEGER CHECK (reviewer_count >= 0),
+    min_reviewers INTEGER CHECK (min_reviewers >= 0),
+    required_approvals INTEGER CHECK (required_approvals >= 0),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+INSERT INTO repositories (name) VALUES ('default');
+
+UPDATE pull_requests SET repository = 'default' WHERE repository = '';
+INSERT INTO repositories (name)
+SELECT DISTINCT repository FROM pull_requests
+ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE pull_requests
+    ALTER COLUMN repository SET DEFAULT 'default',
+    ADD CONSTRAINT pull_requests_repository_fkey FOREIGN KEY (repository) REFERENCES repositories(name),
+    ADD COLUMN number INTEGER CHECK (number > 0),
+    ADD CONSTRAINT pull_requests_repository_number_key UNIQUE (repository, number);
+
+COMMIT;
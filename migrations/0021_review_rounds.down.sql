@@ -0,0 +1,7 @@
+BEGIN;
+
+DROP TABLE IF EXISTS review_rounds;
+ALTER TABLE pull_request_reviewers DROP COLUMN IF EXISTS round;
+ALTER TABLE pull_requests DROP COLUMN IF EXISTS review_round;
+
+COMMIT;
@@ -0,0 +1,19 @@
+BEGIN;
+
+ALTER TABLE pull_requests ADD COLUMN review_round INTEGER NOT NULL DEFAULT 1 CHECK (review_round >= 1);
+ALTER TABLE pull_request_reviewers ADD COLUMN round INTEGER NOT NULL DEFAULT 1;
+
+CREATE TABLE review_rounds (
+    id BIGSERIAL PRIMARY KEY,
+    pull_request_id TEXT NOT NULL REFERENCES pull_requests(id) ON DELETE CASCADE,
+    round INTEGER NOT NULL,
+    reviewer_id TEXT NOT NULL REFERENCES users(id),
+    state TEXT NOT NULL,
+    assigned_at TIMESTAMPTZ NOT NULL,
+    decided_at TIMESTAMPTZ,
+    archived_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_review_rounds_pr ON review_rounds (pull_request_id, round);
+
+COMMIT;